@@ -1,37 +1,132 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"errors"
+	"flag"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	_ "time/tzdata" // IANA time zones for score requests, also in images without zoneinfo
 
+	"ticket-score-engine/internal/alerting"
+	"ticket-score-engine/internal/config"
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/migrations"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/scoring"
 	"ticket-score-engine/internal/server"
 
-	pb "ticket-score-engine/generated" // generated proto package
-
 	"google.golang.org/grpc"
-	_ "modernc.org/sqlite"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+
+	cfg, args, err := config.Load("score-engine", os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unexpected arguments: %v", args)
+	}
+
 	log.Println("Starting Ticket Score Engine...")
+	log.Printf("Effective configuration:\n%s", cfg.Redacted())
+
+	// SIGTERM is how Kubernetes stops a pod; the server then drains its calls
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
 
-	db, err := sql.Open("sqlite", "./database.db")
+	db, dialect, err := database.Open(cfg.Database)
 	if err != nil {
 		log.Fatalf("Failed to open DB: %v", err)
 	}
-	defer db.Close()
 
-	lis, err := net.Listen("tcp", ":50051")
+	if cfg.Features.AutoMigrate {
+		migrator, err := migrations.NewMigrator(db, dialect)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatalf("Failed to migrate DB: %v", err)
+		}
+		for _, m := range applied {
+			log.Printf("Applied migration %04d_%s", m.Version, m.Name)
+		}
+	}
+
+	alerterDone := make(chan struct{})
+	if path := cfg.Features.AlertRulesFile; path != "" {
+		alertConfig, err := alerting.LoadConfig(path)
+		if err != nil {
+			log.Fatalf("Failed to load alert rules: %v", err)
+		}
+		alerter := alerting.NewAlerter(alertConfig.Rules,
+			scoring.NewOverallScorer(repository.NewOverallRepository(db, dialect)),
+			scoring.NewCategoryScorer(repository.NewCategoryRepository(db, dialect)),
+			repository.NewAlertStateRepository(db, dialect),
+			alerting.NewWebhookNotifier(alertConfig.Webhooks, cfg.Timeouts.Webhook))
+		go func() {
+			alerter.Run(ctx, alertConfig.Interval)
+			close(alerterDone)
+		}()
+		log.Printf("Evaluating %d alert rules every %s", len(alertConfig.Rules), alertConfig.Interval)
+	} else {
+		close(alerterDone)
+	}
+
+	opts := server.ServerOptions(cfg.Timeouts.Request)
+	tlsConfig, err := cfg.TLS.ServerTLS()
+	if err != nil {
+		log.Fatalf("Failed to set up TLS: %v", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterScoringServiceServer(grpcServer, server.NewTicketScoreServer(db))
+	grpcServer := grpc.NewServer(opts...)
+	healthServer := health.NewServer()
+	server.RegisterServices(grpcServer, server.NewTicketScoreServer(db, dialect), healthServer, cfg.Features.Reflection)
+
+	checker := server.NewHealthChecker(repository.NewHealthRepository(db), healthServer, cfg.Health.Timeout)
+	checkerDone := make(chan struct{})
+	go func() {
+		checker.Run(ctx, cfg.Health.Interval)
+		close(checkerDone)
+	}()
 
-	log.Println("gRPC server listening on :50051")
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	log.Printf("gRPC server listening on %s", lis.Addr())
+	serveErr := server.Serve(ctx, grpcServer, lis, healthServer, cfg.Timeouts.ShutdownDelay, cfg.Timeouts.Shutdown)
+	if serveErr != nil {
+		log.Printf("Failed to serve: %v", serveErr)
+	}
+
+	// Nothing uses the database once the calls, the alerter and the health
+	// checks have stopped
+	stop()
+	<-alerterDone
+	<-checkerDone
+	if err := db.Close(); err != nil {
+		log.Printf("Failed to close DB: %v", err)
+	}
+	if serveErr != nil {
+		os.Exit(1)
 	}
+	log.Println("Stopped")
 }
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"ticket-score-engine/internal/config"
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/migrations"
+)
+
+const migrateUsage = "usage: score-engine migrate [flags] up|down|status"
+
+// runMigrate implements the "migrate" subcommand and returns the process exit code
+func runMigrate(args []string) int {
+	cfg, args, err := config.Load("score-engine migrate", args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	db, dialect, err := database.Open(cfg.Database)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open DB: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	migrator, err := migrations.NewMigrator(db, dialect)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load migrations: %v\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if reverted == nil {
+			fmt.Println("no migrations to roll back")
+		} else {
+			fmt.Printf("rolled back %04d_%s\n", reverted.Version, reverted.Name)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		w.Flush()
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	return 0
+}
@@ -21,13 +21,393 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Size of the time buckets category scores are aggregated into
+type Granularity int32
+
+const (
+	Granularity_GRANULARITY_AUTO    Granularity = 0 // Daily for ranges up to 30 days, weekly for longer ranges
+	Granularity_GRANULARITY_DAY     Granularity = 1
+	Granularity_GRANULARITY_WEEK    Granularity = 2 // ISO weeks, starting on Monday
+	Granularity_GRANULARITY_MONTH   Granularity = 3
+	Granularity_GRANULARITY_QUARTER Granularity = 4
+	Granularity_GRANULARITY_YEAR    Granularity = 5
+)
+
+// Enum value maps for Granularity.
+var (
+	Granularity_name = map[int32]string{
+		0: "GRANULARITY_AUTO",
+		1: "GRANULARITY_DAY",
+		2: "GRANULARITY_WEEK",
+		3: "GRANULARITY_MONTH",
+		4: "GRANULARITY_QUARTER",
+		5: "GRANULARITY_YEAR",
+	}
+	Granularity_value = map[string]int32{
+		"GRANULARITY_AUTO":    0,
+		"GRANULARITY_DAY":     1,
+		"GRANULARITY_WEEK":    2,
+		"GRANULARITY_MONTH":   3,
+		"GRANULARITY_QUARTER": 4,
+		"GRANULARITY_YEAR":    5,
+	}
+)
+
+func (x Granularity) Enum() *Granularity {
+	p := new(Granularity)
+	*p = x
+	return p
+}
+
+func (x Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[0].Descriptor()
+}
+
+func (Granularity) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[0]
+}
+
+func (x Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Granularity.Descriptor instead.
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{0}
+}
+
+// What smoothed scores are shrunk toward
+type SmoothingMode int32
+
+const (
+	SmoothingMode_SMOOTHING_NONE        SmoothingMode = 0
+	SmoothingMode_SMOOTHING_PRIOR       SmoothingMode = 1 // A fixed prior_score
+	SmoothingMode_SMOOTHING_GLOBAL_MEAN SmoothingMode = 2 // All category buckets returned, or all tickets of the range for the overall score
+)
+
+// Enum value maps for SmoothingMode.
+var (
+	SmoothingMode_name = map[int32]string{
+		0: "SMOOTHING_NONE",
+		1: "SMOOTHING_PRIOR",
+		2: "SMOOTHING_GLOBAL_MEAN",
+	}
+	SmoothingMode_value = map[string]int32{
+		"SMOOTHING_NONE":        0,
+		"SMOOTHING_PRIOR":       1,
+		"SMOOTHING_GLOBAL_MEAN": 2,
+	}
+)
+
+func (x SmoothingMode) Enum() *SmoothingMode {
+	p := new(SmoothingMode)
+	*p = x
+	return p
+}
+
+func (x SmoothingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmoothingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[1].Descriptor()
+}
+
+func (SmoothingMode) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[1]
+}
+
+func (x SmoothingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmoothingMode.Descriptor instead.
+func (SmoothingMode) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{1}
+}
+
+// Current period relative to the present, resolved by the server. Each runs
+// from the start of a day in the request's time zone up to now.
+type PeriodPreset int32
+
+const (
+	PeriodPreset_PERIOD_PRESET_NONE            PeriodPreset = 0 // Use the dates of current_period
+	PeriodPreset_PERIOD_PRESET_LAST_7_DAYS     PeriodPreset = 1 // Today and the 6 days before
+	PeriodPreset_PERIOD_PRESET_WEEK_TO_DATE    PeriodPreset = 2 // Since Monday
+	PeriodPreset_PERIOD_PRESET_MONTH_TO_DATE   PeriodPreset = 3
+	PeriodPreset_PERIOD_PRESET_QUARTER_TO_DATE PeriodPreset = 4
+	PeriodPreset_PERIOD_PRESET_YEAR_TO_DATE    PeriodPreset = 5
+	PeriodPreset_PERIOD_PRESET_ROLLING_DAYS    PeriodPreset = 6 // Today and the rolling_days - 1 days before
+)
+
+// Enum value maps for PeriodPreset.
+var (
+	PeriodPreset_name = map[int32]string{
+		0: "PERIOD_PRESET_NONE",
+		1: "PERIOD_PRESET_LAST_7_DAYS",
+		2: "PERIOD_PRESET_WEEK_TO_DATE",
+		3: "PERIOD_PRESET_MONTH_TO_DATE",
+		4: "PERIOD_PRESET_QUARTER_TO_DATE",
+		5: "PERIOD_PRESET_YEAR_TO_DATE",
+		6: "PERIOD_PRESET_ROLLING_DAYS",
+	}
+	PeriodPreset_value = map[string]int32{
+		"PERIOD_PRESET_NONE":            0,
+		"PERIOD_PRESET_LAST_7_DAYS":     1,
+		"PERIOD_PRESET_WEEK_TO_DATE":    2,
+		"PERIOD_PRESET_MONTH_TO_DATE":   3,
+		"PERIOD_PRESET_QUARTER_TO_DATE": 4,
+		"PERIOD_PRESET_YEAR_TO_DATE":    5,
+		"PERIOD_PRESET_ROLLING_DAYS":    6,
+	}
+)
+
+func (x PeriodPreset) Enum() *PeriodPreset {
+	p := new(PeriodPreset)
+	*p = x
+	return p
+}
+
+func (x PeriodPreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodPreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[2].Descriptor()
+}
+
+func (PeriodPreset) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[2]
+}
+
+func (x PeriodPreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodPreset.Descriptor instead.
+func (PeriodPreset) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{2}
+}
+
+// Previous period to compare with when it is not given explicitly
+type CompareTo int32
+
+const (
+	CompareTo_COMPARE_TO_PRECEDING             CompareTo = 0 // The equivalent period right before, e.g. the same days of the previous month
+	CompareTo_COMPARE_TO_SAME_PERIOD_LAST_YEAR CompareTo = 1 // The same dates one year earlier
+)
+
+// Enum value maps for CompareTo.
+var (
+	CompareTo_name = map[int32]string{
+		0: "COMPARE_TO_PRECEDING",
+		1: "COMPARE_TO_SAME_PERIOD_LAST_YEAR",
+	}
+	CompareTo_value = map[string]int32{
+		"COMPARE_TO_PRECEDING":             0,
+		"COMPARE_TO_SAME_PERIOD_LAST_YEAR": 1,
+	}
+)
+
+func (x CompareTo) Enum() *CompareTo {
+	p := new(CompareTo)
+	*p = x
+	return p
+}
+
+func (x CompareTo) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompareTo) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[3].Descriptor()
+}
+
+func (CompareTo) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[3]
+}
+
+func (x CompareTo) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompareTo.Descriptor instead.
+func (CompareTo) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{3}
+}
+
+// Whether the previous period gives a percentage change
+type BaselineStatus int32
+
+const (
+	BaselineStatus_BASELINE_OK         BaselineStatus = 0
+	BaselineStatus_BASELINE_NO_RATINGS BaselineStatus = 1 // The previous period has no ratings
+	BaselineStatus_BASELINE_ZERO_SCORE BaselineStatus = 2 // The previous score is 0%, so the change has no finite percentage
+)
+
+// Enum value maps for BaselineStatus.
+var (
+	BaselineStatus_name = map[int32]string{
+		0: "BASELINE_OK",
+		1: "BASELINE_NO_RATINGS",
+		2: "BASELINE_ZERO_SCORE",
+	}
+	BaselineStatus_value = map[string]int32{
+		"BASELINE_OK":         0,
+		"BASELINE_NO_RATINGS": 1,
+		"BASELINE_ZERO_SCORE": 2,
+	}
+)
+
+func (x BaselineStatus) Enum() *BaselineStatus {
+	p := new(BaselineStatus)
+	*p = x
+	return p
+}
+
+func (x BaselineStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BaselineStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[4].Descriptor()
+}
+
+func (BaselineStatus) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[4]
+}
+
+func (x BaselineStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BaselineStatus.Descriptor instead.
+func (BaselineStatus) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{4}
+}
+
+// How far a score lies outside its expected range, in multiples of the threshold
+type AnomalySeverity int32
+
+const (
+	AnomalySeverity_ANOMALY_SEVERITY_MINOR    AnomalySeverity = 0 // At least the threshold
+	AnomalySeverity_ANOMALY_SEVERITY_MAJOR    AnomalySeverity = 1 // At least 1.5 times the threshold
+	AnomalySeverity_ANOMALY_SEVERITY_CRITICAL AnomalySeverity = 2 // At least twice the threshold
+)
+
+// Enum value maps for AnomalySeverity.
+var (
+	AnomalySeverity_name = map[int32]string{
+		0: "ANOMALY_SEVERITY_MINOR",
+		1: "ANOMALY_SEVERITY_MAJOR",
+		2: "ANOMALY_SEVERITY_CRITICAL",
+	}
+	AnomalySeverity_value = map[string]int32{
+		"ANOMALY_SEVERITY_MINOR":    0,
+		"ANOMALY_SEVERITY_MAJOR":    1,
+		"ANOMALY_SEVERITY_CRITICAL": 2,
+	}
+)
+
+func (x AnomalySeverity) Enum() *AnomalySeverity {
+	p := new(AnomalySeverity)
+	*p = x
+	return p
+}
+
+func (x AnomalySeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalySeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[5].Descriptor()
+}
+
+func (AnomalySeverity) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[5]
+}
+
+func (x AnomalySeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalySeverity.Descriptor instead.
+func (AnomalySeverity) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{5}
+}
+
+// What a leaderboard ranks. Agents are identified by the tickets' assignee ID
+type LeaderboardDimension int32
+
+const (
+	LeaderboardDimension_LEADERBOARD_DIMENSION_AGENT LeaderboardDimension = 0
+	LeaderboardDimension_LEADERBOARD_DIMENSION_TEAM  LeaderboardDimension = 1
+)
+
+// Enum value maps for LeaderboardDimension.
+var (
+	LeaderboardDimension_name = map[int32]string{
+		0: "LEADERBOARD_DIMENSION_AGENT",
+		1: "LEADERBOARD_DIMENSION_TEAM",
+	}
+	LeaderboardDimension_value = map[string]int32{
+		"LEADERBOARD_DIMENSION_AGENT": 0,
+		"LEADERBOARD_DIMENSION_TEAM":  1,
+	}
+)
+
+func (x LeaderboardDimension) Enum() *LeaderboardDimension {
+	p := new(LeaderboardDimension)
+	*p = x
+	return p
+}
+
+func (x LeaderboardDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_scoring_proto_enumTypes[6].Descriptor()
+}
+
+func (LeaderboardDimension) Type() protoreflect.EnumType {
+	return &file_scoring_proto_enumTypes[6]
+}
+
+func (x LeaderboardDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardDimension.Descriptor instead.
+func (LeaderboardDimension) EnumDescriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{6}
+}
+
 // Request to get scores between two dates
+// The range starts at the beginning of start_date and includes the whole of
+// end_date. For sub-day windows use start_time/end_time instead, which give
+// the range as [start_time, end_time).
 type ScoreRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Format: "YYYY-MM-DD"
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Format: "YYYY-MM-DD"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartDate         string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                // Format: "YYYY-MM-DD", first day of the range
+	EndDate           string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                      // Format: "YYYY-MM-DD", last day of the range (inclusive)
+	Granularity       Granularity            `protobuf:"varint,3,opt,name=granularity,proto3,enum=scoring.Granularity" json:"granularity,omitempty"`                   // Only used by GetCategoryScores
+	TimeZone          string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                                   // IANA time zone of the dates and buckets, e.g. "Asia/Singapore". Defaults to UTC
+	StartTime         string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                // RFC3339 timestamp, alternative to start_date (inclusive)
+	EndTime           string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                      // RFC3339 timestamp, alternative to end_date (exclusive)
+	PageSize          int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                  // Only used by GetTicketScores. Defaults to 100, at most 1000
+	PageToken         string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                // Only used by GetTicketScores and StreamTicketScores, next_page_token of the previous page
+	Filter            *TicketFilter          `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`                                                       // Optional, restricts scores to matching tickets
+	Smoothing         *Smoothing             `protobuf:"bytes,10,opt,name=smoothing,proto3" json:"smoothing,omitempty"`                                                // Only used by GetCategoryScores and GetOverallScore
+	ConfidenceLevel   float64                `protobuf:"fixed64,11,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`           // Of the confidence intervals, between 0 and 1 exclusive. Defaults to 0.95
+	RollingDays       int32                  `protobuf:"varint,12,opt,name=rolling_days,json=rollingDays,proto3" json:"rolling_days,omitempty"`                        // Window of rolling scores, at most 366. Category scores need GRANULARITY_DAY. 0 to disable
+	DecayHalfLifeDays float64                `protobuf:"fixed64,13,opt,name=decay_half_life_days,json=decayHalfLifeDays,proto3" json:"decay_half_life_days,omitempty"` // Half-life of decayed scores in days. 0 to disable
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ScoreRequest) Reset() {
@@ -41,10 +421,2407 @@ func (x *ScoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreRequest) ProtoMessage() {}
+func (*ScoreRequest) ProtoMessage() {}
+
+func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
+func (*ScoreRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScoreRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_AUTO
+}
+
+func (x *ScoreRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetFilter() *TicketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ScoreRequest) GetSmoothing() *Smoothing {
+	if x != nil {
+		return x.Smoothing
+	}
+	return nil
+}
+
+func (x *ScoreRequest) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetRollingDays() int32 {
+	if x != nil {
+		return x.RollingDays
+	}
+	return 0
+}
+
+func (x *ScoreRequest) GetDecayHalfLifeDays() float64 {
+	if x != nil {
+		return x.DecayHalfLifeDays
+	}
+	return 0
+}
+
+// Bayesian smoothing: a score from n effective ratings becomes
+// (n * score + strength * prior) / (n + strength)
+type Smoothing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          SmoothingMode          `protobuf:"varint,1,opt,name=mode,proto3,enum=scoring.SmoothingMode" json:"mode,omitempty"`
+	PriorScore    float64                `protobuf:"fixed64,2,opt,name=prior_score,json=priorScore,proto3" json:"prior_score,omitempty"` // Percentage (0-100), used with SMOOTHING_PRIOR
+	Strength      float64                `protobuf:"fixed64,3,opt,name=strength,proto3" json:"strength,omitempty"`                       // Weight of the prior in ratings. Defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Smoothing) Reset() {
+	*x = Smoothing{}
+	mi := &file_scoring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Smoothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Smoothing) ProtoMessage() {}
+
+func (x *Smoothing) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Smoothing.ProtoReflect.Descriptor instead.
+func (*Smoothing) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Smoothing) GetMode() SmoothingMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SmoothingMode_SMOOTHING_NONE
+}
+
+func (x *Smoothing) GetPriorScore() float64 {
+	if x != nil {
+		return x.PriorScore
+	}
+	return 0
+}
+
+func (x *Smoothing) GetStrength() float64 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+// Range the true score lies in at the given confidence level. A Wilson score
+// interval over the effective sample size, using the weighted variance of the
+// ratings
+type ConfidenceInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lower         float32                `protobuf:"fixed32,1,opt,name=lower,proto3" json:"lower,omitempty"` // Percentage (0-100)
+	Upper         float32                `protobuf:"fixed32,2,opt,name=upper,proto3" json:"upper,omitempty"` // Percentage (0-100)
+	Level         float64                `protobuf:"fixed64,3,opt,name=level,proto3" json:"level,omitempty"` // For example 0.95
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfidenceInterval) Reset() {
+	*x = ConfidenceInterval{}
+	mi := &file_scoring_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfidenceInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidenceInterval) ProtoMessage() {}
+
+func (x *ConfidenceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidenceInterval.ProtoReflect.Descriptor instead.
+func (*ConfidenceInterval) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfidenceInterval) GetLower() float32 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetUpper() float32 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetLevel() float64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// Restricts scores to the ratings of tickets with the given attributes.
+// Every non-empty field has to match, and within a field any value matches.
+type TicketFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssigneeIds   []int32                `protobuf:"varint,1,rep,packed,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	Teams         []string               `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	Channels      []string               `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	Priorities    []string               `protobuf:"bytes,4,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"` // Tickets with at least one of the tags
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketFilter) Reset() {
+	*x = TicketFilter{}
+	mi := &file_scoring_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketFilter) ProtoMessage() {}
+
+func (x *TicketFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketFilter.ProtoReflect.Descriptor instead.
+func (*TicketFilter) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TicketFilter) GetAssigneeIds() []int32 {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *TicketFilter) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *TicketFilter) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *TicketFilter) GetPriorities() []string {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *TicketFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Request for period comparison. The periods are either given as dates in
+// current_period and previous_period, or as a preset. With a preset, or when
+// comparing with the same period last year, previous_period is derived and
+// must not have dates.
+type PeriodComparisonRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPeriod  *ScoreRequest          `protobuf:"bytes,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"` // Its time zone, filter and confidence level apply to both periods
+	PreviousPeriod *ScoreRequest          `protobuf:"bytes,2,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+	Alpha          float64                `protobuf:"fixed64,3,opt,name=alpha,proto3" json:"alpha,omitempty"` // Significance level of the two-sample test, between 0 and 1 exclusive. Defaults to 0.05
+	Preset         PeriodPreset           `protobuf:"varint,4,opt,name=preset,proto3,enum=scoring.PeriodPreset" json:"preset,omitempty"`
+	RollingDays    int32                  `protobuf:"varint,5,opt,name=rolling_days,json=rollingDays,proto3" json:"rolling_days,omitempty"` // Only used with PERIOD_PRESET_ROLLING_DAYS
+	CompareTo      CompareTo              `protobuf:"varint,6,opt,name=compare_to,json=compareTo,proto3,enum=scoring.CompareTo" json:"compare_to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PeriodComparisonRequest) Reset() {
+	*x = PeriodComparisonRequest{}
+	mi := &file_scoring_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodComparisonRequest) ProtoMessage() {}
+
+func (x *PeriodComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodComparisonRequest.ProtoReflect.Descriptor instead.
+func (*PeriodComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PeriodComparisonRequest) GetCurrentPeriod() *ScoreRequest {
+	if x != nil {
+		return x.CurrentPeriod
+	}
+	return nil
+}
+
+func (x *PeriodComparisonRequest) GetPreviousPeriod() *ScoreRequest {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return nil
+}
+
+func (x *PeriodComparisonRequest) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *PeriodComparisonRequest) GetPreset() PeriodPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return PeriodPreset_PERIOD_PRESET_NONE
+}
+
+func (x *PeriodComparisonRequest) GetRollingDays() int32 {
+	if x != nil {
+		return x.RollingDays
+	}
+	return 0
+}
+
+func (x *PeriodComparisonRequest) GetCompareTo() CompareTo {
+	if x != nil {
+		return x.CompareTo
+	}
+	return CompareTo_COMPARE_TO_PRECEDING
+}
+
+// Ranges a comparison was computed over, as RFC3339 timestamps in the
+// request's time zone. Ends are exclusive.
+type ResolvedPeriods struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentStart  string                 `protobuf:"bytes,1,opt,name=current_start,json=currentStart,proto3" json:"current_start,omitempty"`
+	CurrentEnd    string                 `protobuf:"bytes,2,opt,name=current_end,json=currentEnd,proto3" json:"current_end,omitempty"`
+	PreviousStart string                 `protobuf:"bytes,3,opt,name=previous_start,json=previousStart,proto3" json:"previous_start,omitempty"`
+	PreviousEnd   string                 `protobuf:"bytes,4,opt,name=previous_end,json=previousEnd,proto3" json:"previous_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedPeriods) Reset() {
+	*x = ResolvedPeriods{}
+	mi := &file_scoring_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedPeriods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedPeriods) ProtoMessage() {}
+
+func (x *ResolvedPeriods) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedPeriods.ProtoReflect.Descriptor instead.
+func (*ResolvedPeriods) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolvedPeriods) GetCurrentStart() string {
+	if x != nil {
+		return x.CurrentStart
+	}
+	return ""
+}
+
+func (x *ResolvedPeriods) GetCurrentEnd() string {
+	if x != nil {
+		return x.CurrentEnd
+	}
+	return ""
+}
+
+func (x *ResolvedPeriods) GetPreviousStart() string {
+	if x != nil {
+		return x.PreviousStart
+	}
+	return ""
+}
+
+func (x *ResolvedPeriods) GetPreviousEnd() string {
+	if x != nil {
+		return x.PreviousEnd
+	}
+	return ""
+}
+
+// Single category score result
+type CategoryScore struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName        string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Date                string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // Bucket label: "YYYY-MM-DD", "YYYY-WW", "YYYY-MM", "YYYY-Qn" or "YYYY"
+	Score               float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount         int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	PeriodStart         string                 `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`                             // First day of the bucket within the requested range, "YYYY-MM-DD"
+	PeriodEnd           string                 `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`                                   // Last day of the bucket within the requested range, "YYYY-MM-DD"
+	SmoothedScore       float32                `protobuf:"fixed32,7,opt,name=smoothed_score,json=smoothedScore,proto3" json:"smoothed_score,omitempty"`                     // Equal to score without smoothing
+	EffectiveSampleSize float64                `protobuf:"fixed64,8,opt,name=effective_sample_size,json=effectiveSampleSize,proto3" json:"effective_sample_size,omitempty"` // Number of equally weighted ratings the score is worth
+	ConfidenceInterval  *ConfidenceInterval    `protobuf:"bytes,9,opt,name=confidence_interval,json=confidenceInterval,proto3" json:"confidence_interval,omitempty"`        // Of score
+	RollingScore        float32                `protobuf:"fixed32,10,opt,name=rolling_score,json=rollingScore,proto3" json:"rolling_score,omitempty"`                       // Score of the rolling_days days ending with this one, including days before the range
+	RollingRatingCount  int32                  `protobuf:"varint,11,opt,name=rolling_rating_count,json=rollingRatingCount,proto3" json:"rolling_rating_count,omitempty"`
+	DecayedScore        float32                `protobuf:"fixed32,12,opt,name=decayed_score,json=decayedScore,proto3" json:"decayed_score,omitempty"` // Score of this and earlier buckets of the range, decayed by their age
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CategoryScore) Reset() {
+	*x = CategoryScore{}
+	mi := &file_scoring_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryScore) ProtoMessage() {}
+
+func (x *CategoryScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryScore.ProtoReflect.Descriptor instead.
+func (*CategoryScore) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CategoryScore) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryScore) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CategoryScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *CategoryScore) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *CategoryScore) GetSmoothedScore() float32 {
+	if x != nil {
+		return x.SmoothedScore
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetEffectiveSampleSize() float64 {
+	if x != nil {
+		return x.EffectiveSampleSize
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetConfidenceInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ConfidenceInterval
+	}
+	return nil
+}
+
+func (x *CategoryScore) GetRollingScore() float32 {
+	if x != nil {
+		return x.RollingScore
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetRollingRatingCount() int32 {
+	if x != nil {
+		return x.RollingRatingCount
+	}
+	return 0
+}
+
+func (x *CategoryScore) GetDecayedScore() float32 {
+	if x != nil {
+		return x.DecayedScore
+	}
+	return 0
+}
+
+// Response with multiple category scores
+type ScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*CategoryScore       `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	IsWeekly      bool                   `protobuf:"varint,2,opt,name=is_weekly,json=isWeekly,proto3" json:"is_weekly,omitempty"`                // Indicates if aggregation is weekly
+	Granularity   Granularity            `protobuf:"varint,3,opt,name=granularity,proto3,enum=scoring.Granularity" json:"granularity,omitempty"` // Granularity actually used
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreResponse) Reset() {
+	*x = ScoreResponse{}
+	mi := &file_scoring_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreResponse) ProtoMessage() {}
+
+func (x *ScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreResponse.ProtoReflect.Descriptor instead.
+func (*ScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScoreResponse) GetScores() []*CategoryScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ScoreResponse) GetIsWeekly() bool {
+	if x != nil {
+		return x.IsWeekly
+	}
+	return false
+}
+
+func (x *ScoreResponse) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_AUTO
+}
+
+// Per-ticket category score entry
+type TicketScore struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TicketId       int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	CategoryScores map[string]float32     `protobuf:"bytes,2,rep,name=category_scores,json=categoryScores,proto3" json:"category_scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"` // Category name -> percentage score
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TicketScore) Reset() {
+	*x = TicketScore{}
+	mi := &file_scoring_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketScore) ProtoMessage() {}
+
+func (x *TicketScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketScore.ProtoReflect.Descriptor instead.
+func (*TicketScore) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TicketScore) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TicketScore) GetCategoryScores() map[string]float32 {
+	if x != nil {
+		return x.CategoryScores
+	}
+	return nil
+}
+
+// Response containing one page of ticket-level category scores, ordered by ticket ID
+type TicketScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketScores  []*TicketScore         `protobuf:"bytes,1,rep,name=ticket_scores,json=ticketScores,proto3" json:"ticket_scores,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketScoreResponse) Reset() {
+	*x = TicketScoreResponse{}
+	mi := &file_scoring_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketScoreResponse) ProtoMessage() {}
+
+func (x *TicketScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketScoreResponse.ProtoReflect.Descriptor instead.
+func (*TicketScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TicketScoreResponse) GetTicketScores() []*TicketScore {
+	if x != nil {
+		return x.TicketScores
+	}
+	return nil
+}
+
+func (x *TicketScoreResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type OverallScoreResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Score               float32                `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`                                                          // Overall score percentage (0-100)
+	RatingCount         int32                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                            // Total number of ratings
+	SmoothedScore       float32                `protobuf:"fixed32,3,opt,name=smoothed_score,json=smoothedScore,proto3" json:"smoothed_score,omitempty"`                     // Equal to score without smoothing
+	EffectiveSampleSize float64                `protobuf:"fixed64,4,opt,name=effective_sample_size,json=effectiveSampleSize,proto3" json:"effective_sample_size,omitempty"` // Number of equally weighted ratings the score is worth
+	ConfidenceInterval  *ConfidenceInterval    `protobuf:"bytes,5,opt,name=confidence_interval,json=confidenceInterval,proto3" json:"confidence_interval,omitempty"`        // Of score
+	RollingScore        float32                `protobuf:"fixed32,6,opt,name=rolling_score,json=rollingScore,proto3" json:"rolling_score,omitempty"`                        // Score of the rolling_days days ending with the last day of the range
+	RollingRatingCount  int32                  `protobuf:"varint,7,opt,name=rolling_rating_count,json=rollingRatingCount,proto3" json:"rolling_rating_count,omitempty"`
+	DecayedScore        float32                `protobuf:"fixed32,8,opt,name=decayed_score,json=decayedScore,proto3" json:"decayed_score,omitempty"` // Score of the range with every day decayed by its age at the last day
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OverallScoreResponse) Reset() {
+	*x = OverallScoreResponse{}
+	mi := &file_scoring_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverallScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverallScoreResponse) ProtoMessage() {}
+
+func (x *OverallScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverallScoreResponse.ProtoReflect.Descriptor instead.
+func (*OverallScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OverallScoreResponse) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetSmoothedScore() float32 {
+	if x != nil {
+		return x.SmoothedScore
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetEffectiveSampleSize() float64 {
+	if x != nil {
+		return x.EffectiveSampleSize
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetConfidenceInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ConfidenceInterval
+	}
+	return nil
+}
+
+func (x *OverallScoreResponse) GetRollingScore() float32 {
+	if x != nil {
+		return x.RollingScore
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetRollingRatingCount() int32 {
+	if x != nil {
+		return x.RollingRatingCount
+	}
+	return 0
+}
+
+func (x *OverallScoreResponse) GetDecayedScore() float32 {
+	if x != nil {
+		return x.DecayedScore
+	}
+	return 0
+}
+
+type PeriodComparisonResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PercentageChange float32                `protobuf:"fixed32,1,opt,name=percentage_change,json=percentageChange,proto3" json:"percentage_change,omitempty"` // Percentage change between periods, 0 unless baseline is BASELINE_OK
+	CurrentScore     float32                `protobuf:"fixed32,2,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`             // Score for current period
+	PreviousScore    float32                `protobuf:"fixed32,3,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`          // Score for previous period
+	CurrentCount     int32                  `protobuf:"varint,4,opt,name=current_count,json=currentCount,proto3" json:"current_count,omitempty"`              // Rating count for current period
+	PreviousCount    int32                  `protobuf:"varint,5,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty"`           // Rating count for previous period
+	CurrentInterval  *ConfidenceInterval    `protobuf:"bytes,6,opt,name=current_interval,json=currentInterval,proto3" json:"current_interval,omitempty"`      // Of current_score
+	PreviousInterval *ConfidenceInterval    `protobuf:"bytes,7,opt,name=previous_interval,json=previousInterval,proto3" json:"previous_interval,omitempty"`   // Of previous_score
+	AbsoluteChange   float32                `protobuf:"fixed32,8,opt,name=absolute_change,json=absoluteChange,proto3" json:"absolute_change,omitempty"`       // Change in percentage points
+	PValue           float64                `protobuf:"fixed64,9,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`                               // Two-sided p-value of Welch's test that the scores differ, 1 when either period has fewer than 2 effective ratings
+	IsSignificant    bool                   `protobuf:"varint,10,opt,name=is_significant,json=isSignificant,proto3" json:"is_significant,omitempty"`          // p_value is below alpha
+	Baseline         BaselineStatus         `protobuf:"varint,11,opt,name=baseline,proto3,enum=scoring.BaselineStatus" json:"baseline,omitempty"`
+	Periods          *ResolvedPeriods       `protobuf:"bytes,12,opt,name=periods,proto3" json:"periods,omitempty"` // Only set on the top-level response
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PeriodComparisonResponse) Reset() {
+	*x = PeriodComparisonResponse{}
+	mi := &file_scoring_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodComparisonResponse) ProtoMessage() {}
+
+func (x *PeriodComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodComparisonResponse.ProtoReflect.Descriptor instead.
+func (*PeriodComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PeriodComparisonResponse) GetPercentageChange() float32 {
+	if x != nil {
+		return x.PercentageChange
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetCurrentScore() float32 {
+	if x != nil {
+		return x.CurrentScore
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetPreviousScore() float32 {
+	if x != nil {
+		return x.PreviousScore
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetCurrentCount() int32 {
+	if x != nil {
+		return x.CurrentCount
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetPreviousCount() int32 {
+	if x != nil {
+		return x.PreviousCount
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetCurrentInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.CurrentInterval
+	}
+	return nil
+}
+
+func (x *PeriodComparisonResponse) GetPreviousInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.PreviousInterval
+	}
+	return nil
+}
+
+func (x *PeriodComparisonResponse) GetAbsoluteChange() float32 {
+	if x != nil {
+		return x.AbsoluteChange
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *PeriodComparisonResponse) GetIsSignificant() bool {
+	if x != nil {
+		return x.IsSignificant
+	}
+	return false
+}
+
+func (x *PeriodComparisonResponse) GetBaseline() BaselineStatus {
+	if x != nil {
+		return x.Baseline
+	}
+	return BaselineStatus_BASELINE_OK
+}
+
+func (x *PeriodComparisonResponse) GetPeriods() *ResolvedPeriods {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+// Comparison of a single category, see GetCategoryPeriodComparison
+type CategoryComparison struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	CategoryName  string                    `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Comparison    *PeriodComparisonResponse `protobuf:"bytes,2,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryComparison) Reset() {
+	*x = CategoryComparison{}
+	mi := &file_scoring_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryComparison) ProtoMessage() {}
+
+func (x *CategoryComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryComparison.ProtoReflect.Descriptor instead.
+func (*CategoryComparison) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CategoryComparison) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryComparison) GetComparison() *PeriodComparisonResponse {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+// Categories ordered by the biggest regression in points first. Categories
+// rated in only one of the periods come last, ordered by name.
+type CategoryPeriodComparisonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryComparison  `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Periods       *ResolvedPeriods       `protobuf:"bytes,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryPeriodComparisonResponse) Reset() {
+	*x = CategoryPeriodComparisonResponse{}
+	mi := &file_scoring_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPeriodComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPeriodComparisonResponse) ProtoMessage() {}
+
+func (x *CategoryPeriodComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPeriodComparisonResponse.ProtoReflect.Descriptor instead.
+func (*CategoryPeriodComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryPeriodComparisonResponse) GetCategories() []*CategoryComparison {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CategoryPeriodComparisonResponse) GetPeriods() *ResolvedPeriods {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type ScoreTrendRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Period            *ScoreRequest          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                                 // Range, granularity, time zone, filter and confidence level. The granularity must be day, week or month, with at most 366 buckets
+	IncludeCategories bool                   `protobuf:"varint,2,opt,name=include_categories,json=includeCategories,proto3" json:"include_categories,omitempty"` // Also return a series per category
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScoreTrendRequest) Reset() {
+	*x = ScoreTrendRequest{}
+	mi := &file_scoring_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreTrendRequest) ProtoMessage() {}
+
+func (x *ScoreTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreTrendRequest.ProtoReflect.Descriptor instead.
+func (*ScoreTrendRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScoreTrendRequest) GetPeriod() *ScoreRequest {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ScoreTrendRequest) GetIncludeCategories() bool {
+	if x != nil {
+		return x.IncludeCategories
+	}
+	return false
+}
+
+// Score of one bucket. Buckets without ratings have a rating_count of 0.
+type TrendPoint struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Date               string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                  // Bucket label, as in CategoryScore
+	PeriodStart        string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // First day of the bucket within the requested range, "YYYY-MM-DD"
+	PeriodEnd          string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Last day of the bucket within the requested range, "YYYY-MM-DD"
+	Score              float32                `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount        int32                  `protobuf:"varint,5,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	ConfidenceInterval *ConfidenceInterval    `protobuf:"bytes,6,opt,name=confidence_interval,json=confidenceInterval,proto3" json:"confidence_interval,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TrendPoint) Reset() {
+	*x = TrendPoint{}
+	mi := &file_scoring_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendPoint) ProtoMessage() {}
+
+func (x *TrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendPoint.ProtoReflect.Descriptor instead.
+func (*TrendPoint) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrendPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TrendPoint) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *TrendPoint) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *TrendPoint) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetConfidenceInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.ConfidenceInterval
+	}
+	return nil
+}
+
+type CategoryTrend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Points        []*TrendPoint          `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"` // One per bucket, aligned with overall
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTrend) Reset() {
+	*x = CategoryTrend{}
+	mi := &file_scoring_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTrend) ProtoMessage() {}
+
+func (x *CategoryTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTrend.ProtoReflect.Descriptor instead.
+func (*CategoryTrend) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CategoryTrend) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryTrend) GetPoints() []*TrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// Contiguous series covering the whole range, one point per bucket
+type ScoreTrendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Granularity   Granularity            `protobuf:"varint,1,opt,name=granularity,proto3,enum=scoring.Granularity" json:"granularity,omitempty"` // Granularity actually used
+	Overall       []*TrendPoint          `protobuf:"bytes,2,rep,name=overall,proto3" json:"overall,omitempty"`
+	Categories    []*CategoryTrend       `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"` // Categories rated in the range, ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreTrendResponse) Reset() {
+	*x = ScoreTrendResponse{}
+	mi := &file_scoring_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreTrendResponse) ProtoMessage() {}
+
+func (x *ScoreTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreTrendResponse.ProtoReflect.Descriptor instead.
+func (*ScoreTrendResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScoreTrendResponse) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_AUTO
+}
+
+func (x *ScoreTrendResponse) GetOverall() []*TrendPoint {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *ScoreTrendResponse) GetCategories() []*CategoryTrend {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ScoreForecastRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Weeks             int32                  `protobuf:"varint,1,opt,name=weeks,proto3" json:"weeks,omitempty"`                                                  // Weeks to project, starting with the current one, at most 26. Defaults to 4
+	HistoryWeeks      int32                  `protobuf:"varint,2,opt,name=history_weeks,json=historyWeeks,proto3" json:"history_weeks,omitempty"`                // Complete weeks before the current one to fit, between 3 and 104. Defaults to 12
+	TimeZone          string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                             // IANA time zone of the weeks. Defaults to UTC
+	Filter            *TicketFilter          `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                                 // Optional, restricts the history to matching tickets
+	ConfidenceLevel   float64                `protobuf:"fixed64,5,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`      // Of the prediction intervals, between 0 and 1 exclusive. Defaults to 0.95
+	IncludeCategories bool                   `protobuf:"varint,6,opt,name=include_categories,json=includeCategories,proto3" json:"include_categories,omitempty"` // Also forecast every category with at least 3 rated weeks
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScoreForecastRequest) Reset() {
+	*x = ScoreForecastRequest{}
+	mi := &file_scoring_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreForecastRequest) ProtoMessage() {}
+
+func (x *ScoreForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreForecastRequest.ProtoReflect.Descriptor instead.
+func (*ScoreForecastRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScoreForecastRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *ScoreForecastRequest) GetHistoryWeeks() int32 {
+	if x != nil {
+		return x.HistoryWeeks
+	}
+	return 0
+}
+
+func (x *ScoreForecastRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ScoreForecastRequest) GetFilter() *TicketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ScoreForecastRequest) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *ScoreForecastRequest) GetIncludeCategories() bool {
+	if x != nil {
+		return x.IncludeCategories
+	}
+	return false
+}
+
+// Projected score of one upcoming week
+type ForecastPoint struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Date               string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                  // Week label, "YYYY-WW"
+	PeriodStart        string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Monday of the week, "YYYY-MM-DD"
+	PeriodEnd          string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Sunday of the week, "YYYY-MM-DD"
+	Score              float32                `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	PredictionInterval *ConfidenceInterval    `protobuf:"bytes,5,opt,name=prediction_interval,json=predictionInterval,proto3" json:"prediction_interval,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ForecastPoint) Reset() {
+	*x = ForecastPoint{}
+	mi := &file_scoring_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastPoint) ProtoMessage() {}
+
+func (x *ForecastPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastPoint.ProtoReflect.Descriptor instead.
+func (*ForecastPoint) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ForecastPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ForecastPoint) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ForecastPoint) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *ForecastPoint) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetPredictionInterval() *ConfidenceInterval {
+	if x != nil {
+		return x.PredictionInterval
+	}
+	return nil
+}
+
+type CategoryForecast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Points        []*ForecastPoint       `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"` // One per week, aligned with overall
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryForecast) Reset() {
+	*x = CategoryForecast{}
+	mi := &file_scoring_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryForecast) ProtoMessage() {}
+
+func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryForecast.ProtoReflect.Descriptor instead.
+func (*CategoryForecast) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CategoryForecast) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetPoints() []*ForecastPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ScoreForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overall       []*ForecastPoint       `protobuf:"bytes,1,rep,name=overall,proto3" json:"overall,omitempty"`
+	Categories    []*CategoryForecast    `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // Ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreForecastResponse) Reset() {
+	*x = ScoreForecastResponse{}
+	mi := &file_scoring_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreForecastResponse) ProtoMessage() {}
+
+func (x *ScoreForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreForecastResponse.ProtoReflect.Descriptor instead.
+func (*ScoreForecastResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScoreForecastResponse) GetOverall() []*ForecastPoint {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *ScoreForecastResponse) GetCategories() []*CategoryForecast {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type AnomalyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *ScoreRequest          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                     // Days to check, time zone and filter. Granularity is always daily
+	Threshold     float64                `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`                             // Robust z-score from which a day is flagged. Defaults to 3.5
+	BaselineWeeks int32                  `protobuf:"varint,3,opt,name=baseline_weeks,json=baselineWeeks,proto3" json:"baseline_weeks,omitempty"` // Weeks before each day whose same weekday makes its baseline, at most 52. Defaults to 8
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyRequest) Reset() {
+	*x = AnomalyRequest{}
+	mi := &file_scoring_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyRequest) ProtoMessage() {}
+
+func (x *AnomalyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyRequest.ProtoReflect.Descriptor instead.
+func (*AnomalyRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnomalyRequest) GetPeriod() *ScoreRequest {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *AnomalyRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AnomalyRequest) GetBaselineWeeks() int32 {
+	if x != nil {
+		return x.BaselineWeeks
+	}
+	return 0
+}
+
+// A daily category score outside the range expected from the same weekday of
+// the weeks before. Days need at least 3 rated baseline days to be judged.
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // "YYYY-MM-DD"
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	ExpectedScore float32                `protobuf:"fixed32,5,opt,name=expected_score,json=expectedScore,proto3" json:"expected_score,omitempty"` // Median of the baseline days
+	ExpectedLower float32                `protobuf:"fixed32,6,opt,name=expected_lower,json=expectedLower,proto3" json:"expected_lower,omitempty"` // Lowest score that would not have been flagged
+	ExpectedUpper float32                `protobuf:"fixed32,7,opt,name=expected_upper,json=expectedUpper,proto3" json:"expected_upper,omitempty"` // Highest score that would not have been flagged
+	ZScore        float64                `protobuf:"fixed64,8,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`                      // Negative for drops
+	Severity      AnomalySeverity        `protobuf:"varint,9,opt,name=severity,proto3,enum=scoring.AnomalySeverity" json:"severity,omitempty"`
+	BaselineDays  int32                  `protobuf:"varint,10,opt,name=baseline_days,json=baselineDays,proto3" json:"baseline_days,omitempty"` // Rated days the baseline is made of
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_scoring_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Anomaly) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Anomaly) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Anomaly) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Anomaly) GetExpectedScore() float32 {
+	if x != nil {
+		return x.ExpectedScore
+	}
+	return 0
+}
+
+func (x *Anomaly) GetExpectedLower() float32 {
+	if x != nil {
+		return x.ExpectedLower
+	}
+	return 0
+}
+
+func (x *Anomaly) GetExpectedUpper() float32 {
+	if x != nil {
+		return x.ExpectedUpper
+	}
+	return 0
+}
+
+func (x *Anomaly) GetZScore() float64 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+func (x *Anomaly) GetSeverity() AnomalySeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnomalySeverity_ANOMALY_SEVERITY_MINOR
+}
+
+func (x *Anomaly) GetBaselineDays() int32 {
+	if x != nil {
+		return x.BaselineDays
+	}
+	return 0
+}
+
+type AnomalyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"` // Ordered by date, then category name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyResponse) Reset() {
+	*x = AnomalyResponse{}
+	mi := &file_scoring_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyResponse) ProtoMessage() {}
+
+func (x *AnomalyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyResponse.ProtoReflect.Descriptor instead.
+func (*AnomalyResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AnomalyResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type LeaderboardRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         *ScoreRequest          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                       // Range, time zone and filter to rank
+	PreviousPeriod *ScoreRequest          `protobuf:"bytes,2,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"` // Optional, defaults to the equally long period before
+	Dimension      LeaderboardDimension   `protobuf:"varint,3,opt,name=dimension,proto3,enum=scoring.LeaderboardDimension" json:"dimension,omitempty"`
+	MinRatingCount int32                  `protobuf:"varint,4,opt,name=min_rating_count,json=minRatingCount,proto3" json:"min_rating_count,omitempty"` // Agents or teams with fewer ratings are not ranked
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                           // Optional, number of entries to return. 0 returns all
+	WorstFirst     bool                   `protobuf:"varint,6,opt,name=worst_first,json=worstFirst,proto3" json:"worst_first,omitempty"`               // Return the lowest ranks first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_scoring_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LeaderboardRequest) GetPeriod() *ScoreRequest {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *LeaderboardRequest) GetPreviousPeriod() *ScoreRequest {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return nil
+}
+
+func (x *LeaderboardRequest) GetDimension() LeaderboardDimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return LeaderboardDimension_LEADERBOARD_DIMENSION_AGENT
+}
+
+func (x *LeaderboardRequest) GetMinRatingCount() int32 {
+	if x != nil {
+		return x.MinRatingCount
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetWorstFirst() bool {
+	if x != nil {
+		return x.WorstFirst
+	}
+	return false
+}
+
+type LeaderboardCategoryScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,3,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardCategoryScore) Reset() {
+	*x = LeaderboardCategoryScore{}
+	mi := &file_scoring_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardCategoryScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardCategoryScore) ProtoMessage() {}
+
+func (x *LeaderboardCategoryScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardCategoryScore.ProtoReflect.Descriptor instead.
+func (*LeaderboardCategoryScore) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LeaderboardCategoryScore) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *LeaderboardCategoryScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeaderboardCategoryScore) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Key           string                      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`    // Assignee ID or team name
+	Rank          int32                       `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"` // 1 is the best score, ties share a rank
+	Score         float32                     `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount   int32                       `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Categories    []*LeaderboardCategoryScore `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`                          // Ordered by category name
+	PreviousRank  int32                       `protobuf:"varint,6,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"` // 0 when not ranked in the previous period
+	RankChange    int32                       `protobuf:"varint,7,opt,name=rank_change,json=rankChange,proto3" json:"rank_change,omitempty"`       // Positive when moved up since the previous period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_scoring_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LeaderboardEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetCategories() []*LeaderboardCategoryScore {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRankChange() int32 {
+	if x != nil {
+		return x.RankChange
+	}
+	return 0
+}
+
+type LeaderboardResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Entries             []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	PreviousPeriodStart string                 `protobuf:"bytes,2,opt,name=previous_period_start,json=previousPeriodStart,proto3" json:"previous_period_start,omitempty"` // RFC3339, the previous period compared against
+	PreviousPeriodEnd   string                 `protobuf:"bytes,3,opt,name=previous_period_end,json=previousPeriodEnd,proto3" json:"previous_period_end,omitempty"`       // RFC3339, exclusive
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_scoring_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardResponse) ProtoMessage() {}
+
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LeaderboardResponse) GetPreviousPeriodStart() string {
+	if x != nil {
+		return x.PreviousPeriodStart
+	}
+	return ""
+}
+
+func (x *LeaderboardResponse) GetPreviousPeriodEnd() string {
+	if x != nil {
+		return x.PreviousPeriodEnd
+	}
+	return ""
+}
+
+// A single rating given to a ticket in a category
+type SubmitRatingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TicketId         int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	RatingCategoryId int32                  `protobuf:"varint,2,opt,name=rating_category_id,json=ratingCategoryId,proto3" json:"rating_category_id,omitempty"`
+	Rating           int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                           // Rating value (0-5)
+	ReviewerId       int32                  `protobuf:"varint,4,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"` // Optional
+	RevieweeId       int32                  `protobuf:"varint,5,opt,name=reviewee_id,json=revieweeId,proto3" json:"reviewee_id,omitempty"` // Optional
+	CreatedAt        string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`     // Optional, RFC 3339. Defaults to the time of submission
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubmitRatingRequest) Reset() {
+	*x = SubmitRatingRequest{}
+	mi := &file_scoring_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingRequest) ProtoMessage() {}
+
+func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRatingRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitRatingRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetRatingCategoryId() int32 {
+	if x != nil {
+		return x.RatingCategoryId
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetReviewerId() int32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetRevieweeId() int32 {
+	if x != nil {
+		return x.RevieweeId
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SubmitRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingId      int64                  `protobuf:"varint,1,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingResponse) Reset() {
+	*x = SubmitRatingResponse{}
+	mi := &file_scoring_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingResponse) ProtoMessage() {}
+
+func (x *SubmitRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingResponse.ProtoReflect.Descriptor instead.
+func (*SubmitRatingResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitRatingResponse) GetRatingId() int64 {
+	if x != nil {
+		return x.RatingId
+	}
+	return 0
+}
+
+type BatchSubmitRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*SubmitRatingRequest `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSubmitRatingsRequest) Reset() {
+	*x = BatchSubmitRatingsRequest{}
+	mi := &file_scoring_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSubmitRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubmitRatingsRequest) ProtoMessage() {}
+
+func (x *BatchSubmitRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubmitRatingsRequest.ProtoReflect.Descriptor instead.
+func (*BatchSubmitRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchSubmitRatingsRequest) GetRatings() []*SubmitRatingRequest {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+// Outcome of a single rating within a batch
+type RatingResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                       // Position of the rating in the request
+	RatingId      int64                  `protobuf:"varint,2,opt,name=rating_id,json=ratingId,proto3" json:"rating_id,omitempty"` // Set when the rating was stored
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                        // Set when the rating was rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingResult) Reset() {
+	*x = RatingResult{}
+	mi := &file_scoring_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingResult) ProtoMessage() {}
+
+func (x *RatingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingResult.ProtoReflect.Descriptor instead.
+func (*RatingResult) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RatingResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RatingResult) GetRatingId() int64 {
+	if x != nil {
+		return x.RatingId
+	}
+	return 0
+}
+
+func (x *RatingResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchSubmitRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RatingResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AcceptedCount int32                  `protobuf:"varint,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	RejectedCount int32                  `protobuf:"varint,3,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSubmitRatingsResponse) Reset() {
+	*x = BatchSubmitRatingsResponse{}
+	mi := &file_scoring_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSubmitRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubmitRatingsResponse) ProtoMessage() {}
 
-func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[0]
+func (x *BatchSubmitRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,49 +2832,57 @@ func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
-func (*ScoreRequest) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BatchSubmitRatingsResponse.ProtoReflect.Descriptor instead.
+func (*BatchSubmitRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ScoreRequest) GetStartDate() string {
+func (x *BatchSubmitRatingsResponse) GetResults() []*RatingResult {
 	if x != nil {
-		return x.StartDate
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *ScoreRequest) GetEndDate() string {
+func (x *BatchSubmitRatingsResponse) GetAcceptedCount() int32 {
 	if x != nil {
-		return x.EndDate
+		return x.AcceptedCount
 	}
-	return ""
+	return 0
 }
 
-// Request for period comparison
-type PeriodComparisonRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPeriod  *ScoreRequest          `protobuf:"bytes,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
-	PreviousPeriod *ScoreRequest          `protobuf:"bytes,2,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BatchSubmitRatingsResponse) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
 }
 
-func (x *PeriodComparisonRequest) Reset() {
-	*x = PeriodComparisonRequest{}
-	mi := &file_scoring_proto_msgTypes[1]
+// One version of a category weight
+type CategoryWeight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // RFC 3339
+	EffectiveTo   string                 `protobuf:"bytes,3,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`       // RFC 3339, empty while the weight is in effect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryWeight) Reset() {
+	*x = CategoryWeight{}
+	mi := &file_scoring_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PeriodComparisonRequest) String() string {
+func (x *CategoryWeight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeriodComparisonRequest) ProtoMessage() {}
+func (*CategoryWeight) ProtoMessage() {}
 
-func (x *PeriodComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[1]
+func (x *CategoryWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,51 +2893,59 @@ func (x *PeriodComparisonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeriodComparisonRequest.ProtoReflect.Descriptor instead.
-func (*PeriodComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CategoryWeight.ProtoReflect.Descriptor instead.
+func (*CategoryWeight) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PeriodComparisonRequest) GetCurrentPeriod() *ScoreRequest {
+func (x *CategoryWeight) GetWeight() float64 {
 	if x != nil {
-		return x.CurrentPeriod
+		return x.Weight
 	}
-	return nil
+	return 0
 }
 
-func (x *PeriodComparisonRequest) GetPreviousPeriod() *ScoreRequest {
+func (x *CategoryWeight) GetEffectiveFrom() string {
 	if x != nil {
-		return x.PreviousPeriod
+		return x.EffectiveFrom
 	}
-	return nil
+	return ""
 }
 
-// Single category score result
-type CategoryScore struct {
+func (x *CategoryWeight) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+type RatingCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
-	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"` // Most recently set weight
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	ArchivedAt    string                 `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // RFC 3339, set when archived
+	WeightHistory []*CategoryWeight      `protobuf:"bytes,6,rep,name=weight_history,json=weightHistory,proto3" json:"weight_history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CategoryScore) Reset() {
-	*x = CategoryScore{}
-	mi := &file_scoring_proto_msgTypes[2]
+func (x *RatingCategory) Reset() {
+	*x = RatingCategory{}
+	mi := &file_scoring_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryScore) String() string {
+func (x *RatingCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryScore) ProtoMessage() {}
+func (*RatingCategory) ProtoMessage() {}
 
-func (x *CategoryScore) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[2]
+func (x *RatingCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -163,63 +2956,76 @@ func (x *CategoryScore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryScore.ProtoReflect.Descriptor instead.
-func (*CategoryScore) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RatingCategory.ProtoReflect.Descriptor instead.
+func (*RatingCategory) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CategoryScore) GetCategoryName() string {
+func (x *RatingCategory) GetId() int32 {
 	if x != nil {
-		return x.CategoryName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CategoryScore) GetDate() string {
+func (x *RatingCategory) GetName() string {
 	if x != nil {
-		return x.Date
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CategoryScore) GetScore() float32 {
+func (x *RatingCategory) GetWeight() float64 {
 	if x != nil {
-		return x.Score
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *CategoryScore) GetRatingCount() int32 {
+func (x *RatingCategory) GetArchived() bool {
 	if x != nil {
-		return x.RatingCount
+		return x.Archived
 	}
-	return 0
+	return false
 }
 
-// Response with multiple category scores
-type ScoreResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scores        []*CategoryScore       `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
-	IsWeekly      bool                   `protobuf:"varint,2,opt,name=is_weekly,json=isWeekly,proto3" json:"is_weekly,omitempty"` // Indicates if aggregation is weekly
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RatingCategory) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
 }
 
-func (x *ScoreResponse) Reset() {
-	*x = ScoreResponse{}
-	mi := &file_scoring_proto_msgTypes[3]
+func (x *RatingCategory) GetWeightHistory() []*CategoryWeight {
+	if x != nil {
+		return x.WeightHistory
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived      bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	IncludeWeightHistory bool                   `protobuf:"varint,2,opt,name=include_weight_history,json=includeWeightHistory,proto3" json:"include_weight_history,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_scoring_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScoreResponse) String() string {
+func (x *ListCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreResponse) ProtoMessage() {}
+func (*ListCategoriesRequest) ProtoMessage() {}
 
-func (x *ScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[3]
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,49 +3036,47 @@ func (x *ScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreResponse.ProtoReflect.Descriptor instead.
-func (*ScoreResponse) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ScoreResponse) GetScores() []*CategoryScore {
+func (x *ListCategoriesRequest) GetIncludeArchived() bool {
 	if x != nil {
-		return x.Scores
+		return x.IncludeArchived
 	}
-	return nil
+	return false
 }
 
-func (x *ScoreResponse) GetIsWeekly() bool {
+func (x *ListCategoriesRequest) GetIncludeWeightHistory() bool {
 	if x != nil {
-		return x.IsWeekly
+		return x.IncludeWeightHistory
 	}
 	return false
 }
 
-// Per-ticket category score entry
-type TicketScore struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TicketId       int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
-	CategoryScores map[string]float32     `protobuf:"bytes,2,rep,name=category_scores,json=categoryScores,proto3" json:"category_scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"` // Category name -> percentage score
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*RatingCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TicketScore) Reset() {
-	*x = TicketScore{}
-	mi := &file_scoring_proto_msgTypes[4]
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_scoring_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TicketScore) String() string {
+func (x *ListCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TicketScore) ProtoMessage() {}
+func (*ListCategoriesResponse) ProtoMessage() {}
 
-func (x *TicketScore) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[4]
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,48 +3087,42 @@ func (x *TicketScore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TicketScore.ProtoReflect.Descriptor instead.
-func (*TicketScore) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *TicketScore) GetTicketId() int32 {
-	if x != nil {
-		return x.TicketId
-	}
-	return 0
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *TicketScore) GetCategoryScores() map[string]float32 {
+func (x *ListCategoriesResponse) GetCategories() []*RatingCategory {
 	if x != nil {
-		return x.CategoryScores
+		return x.Categories
 	}
 	return nil
 }
 
-// Response containing ticket-level category scores
-type TicketScoreResponse struct {
+type CreateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TicketScores  []*TicketScore         `protobuf:"bytes,1,rep,name=ticket_scores,json=ticketScores,proto3" json:"ticket_scores,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Optional, "YYYY-MM-DD" or RFC 3339. Defaults to now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TicketScoreResponse) Reset() {
-	*x = TicketScoreResponse{}
-	mi := &file_scoring_proto_msgTypes[5]
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_scoring_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TicketScoreResponse) String() string {
+func (x *CreateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TicketScoreResponse) ProtoMessage() {}
+func (*CreateCategoryRequest) ProtoMessage() {}
 
-func (x *TicketScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[5]
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,41 +3133,55 @@ func (x *TicketScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TicketScoreResponse.ProtoReflect.Descriptor instead.
-func (*TicketScoreResponse) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *TicketScoreResponse) GetTicketScores() []*TicketScore {
+func (x *CreateCategoryRequest) GetName() string {
 	if x != nil {
-		return x.TicketScores
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type OverallScoreResponse struct {
+func (x *CreateCategoryRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+type RenameCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Score         float32                `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`                               // Overall score percentage (0-100)
-	RatingCount   int32                  `protobuf:"varint,2,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"` // Total number of ratings
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverallScoreResponse) Reset() {
-	*x = OverallScoreResponse{}
-	mi := &file_scoring_proto_msgTypes[6]
+func (x *RenameCategoryRequest) Reset() {
+	*x = RenameCategoryRequest{}
+	mi := &file_scoring_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverallScoreResponse) String() string {
+func (x *RenameCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverallScoreResponse) ProtoMessage() {}
+func (*RenameCategoryRequest) ProtoMessage() {}
 
-func (x *OverallScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[6]
+func (x *RenameCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,51 +3192,50 @@ func (x *OverallScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverallScoreResponse.ProtoReflect.Descriptor instead.
-func (*OverallScoreResponse) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RenameCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RenameCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *OverallScoreResponse) GetScore() float32 {
+func (x *RenameCategoryRequest) GetCategoryId() int32 {
 	if x != nil {
-		return x.Score
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *OverallScoreResponse) GetRatingCount() int32 {
+func (x *RenameCategoryRequest) GetName() string {
 	if x != nil {
-		return x.RatingCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type PeriodComparisonResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	PercentageChange float32                `protobuf:"fixed32,1,opt,name=percentage_change,json=percentageChange,proto3" json:"percentage_change,omitempty"` // Percentage change between periods
-	CurrentScore     float32                `protobuf:"fixed32,2,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`             // Score for current period
-	PreviousScore    float32                `protobuf:"fixed32,3,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`          // Score for previous period
-	CurrentCount     int32                  `protobuf:"varint,4,opt,name=current_count,json=currentCount,proto3" json:"current_count,omitempty"`              // Rating count for current period
-	PreviousCount    int32                  `protobuf:"varint,5,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty"`           // Rating count for previous period
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Weight changes only apply to ratings made on or after effective_from
+type UpdateCategoryWeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Optional, "YYYY-MM-DD" or RFC 3339. Defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PeriodComparisonResponse) Reset() {
-	*x = PeriodComparisonResponse{}
-	mi := &file_scoring_proto_msgTypes[7]
+func (x *UpdateCategoryWeightRequest) Reset() {
+	*x = UpdateCategoryWeightRequest{}
+	mi := &file_scoring_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PeriodComparisonResponse) String() string {
+func (x *UpdateCategoryWeightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeriodComparisonResponse) ProtoMessage() {}
+func (*UpdateCategoryWeightRequest) ProtoMessage() {}
 
-func (x *PeriodComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scoring_proto_msgTypes[7]
+func (x *UpdateCategoryWeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,42 +3246,72 @@ func (x *PeriodComparisonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeriodComparisonResponse.ProtoReflect.Descriptor instead.
-func (*PeriodComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_scoring_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateCategoryWeightRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryWeightRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PeriodComparisonResponse) GetPercentageChange() float32 {
+func (x *UpdateCategoryWeightRequest) GetCategoryId() int32 {
 	if x != nil {
-		return x.PercentageChange
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *PeriodComparisonResponse) GetCurrentScore() float32 {
+func (x *UpdateCategoryWeightRequest) GetWeight() float64 {
 	if x != nil {
-		return x.CurrentScore
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *PeriodComparisonResponse) GetPreviousScore() float32 {
+func (x *UpdateCategoryWeightRequest) GetEffectiveFrom() string {
 	if x != nil {
-		return x.PreviousScore
+		return x.EffectiveFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *PeriodComparisonResponse) GetCurrentCount() int32 {
+type ArchiveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCategoryRequest) Reset() {
+	*x = ArchiveCategoryRequest{}
+	mi := &file_scoring_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCategoryRequest) ProtoMessage() {}
+
+func (x *ArchiveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scoring_proto_msgTypes[41]
 	if x != nil {
-		return x.CurrentCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PeriodComparisonResponse) GetPreviousCount() int32 {
+// Deprecated: Use ArchiveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_scoring_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ArchiveCategoryRequest) GetCategoryId() int32 {
 	if x != nil {
-		return x.PreviousCount
+		return x.CategoryId
 	}
 	return 0
 }
@@ -479,44 +3320,314 @@ var File_scoring_proto protoreflect.FileDescriptor
 
 const file_scoring_proto_rawDesc = "" +
 	"\n" +
-	"\rscoring.proto\x12\ascoring\"H\n" +
+	"\rscoring.proto\x12\ascoring\"\xf3\x03\n" +
 	"\fScoreRequest\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\x97\x01\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x126\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2\x14.scoring.GranularityR\vgranularity\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12-\n" +
+	"\x06filter\x18\t \x01(\v2\x15.scoring.TicketFilterR\x06filter\x120\n" +
+	"\tsmoothing\x18\n" +
+	" \x01(\v2\x12.scoring.SmoothingR\tsmoothing\x12)\n" +
+	"\x10confidence_level\x18\v \x01(\x01R\x0fconfidenceLevel\x12!\n" +
+	"\frolling_days\x18\f \x01(\x05R\vrollingDays\x12/\n" +
+	"\x14decay_half_life_days\x18\r \x01(\x01R\x11decayHalfLifeDays\"t\n" +
+	"\tSmoothing\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x16.scoring.SmoothingModeR\x04mode\x12\x1f\n" +
+	"\vprior_score\x18\x02 \x01(\x01R\n" +
+	"priorScore\x12\x1a\n" +
+	"\bstrength\x18\x03 \x01(\x01R\bstrength\"V\n" +
+	"\x12ConfidenceInterval\x12\x14\n" +
+	"\x05lower\x18\x01 \x01(\x02R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x02 \x01(\x02R\x05upper\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x01R\x05level\"\x97\x01\n" +
+	"\fTicketFilter\x12!\n" +
+	"\fassignee_ids\x18\x01 \x03(\x05R\vassigneeIds\x12\x14\n" +
+	"\x05teams\x18\x02 \x03(\tR\x05teams\x12\x1a\n" +
+	"\bchannels\x18\x03 \x03(\tR\bchannels\x12\x1e\n" +
+	"\n" +
+	"priorities\x18\x04 \x03(\tR\n" +
+	"priorities\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xb2\x02\n" +
 	"\x17PeriodComparisonRequest\x12<\n" +
 	"\x0ecurrent_period\x18\x01 \x01(\v2\x15.scoring.ScoreRequestR\rcurrentPeriod\x12>\n" +
-	"\x0fprevious_period\x18\x02 \x01(\v2\x15.scoring.ScoreRequestR\x0epreviousPeriod\"\x81\x01\n" +
+	"\x0fprevious_period\x18\x02 \x01(\v2\x15.scoring.ScoreRequestR\x0epreviousPeriod\x12\x14\n" +
+	"\x05alpha\x18\x03 \x01(\x01R\x05alpha\x12-\n" +
+	"\x06preset\x18\x04 \x01(\x0e2\x15.scoring.PeriodPresetR\x06preset\x12!\n" +
+	"\frolling_days\x18\x05 \x01(\x05R\vrollingDays\x121\n" +
+	"\n" +
+	"compare_to\x18\x06 \x01(\x0e2\x12.scoring.CompareToR\tcompareTo\"\xa1\x01\n" +
+	"\x0fResolvedPeriods\x12#\n" +
+	"\rcurrent_start\x18\x01 \x01(\tR\fcurrentStart\x12\x1f\n" +
+	"\vcurrent_end\x18\x02 \x01(\tR\n" +
+	"currentEnd\x12%\n" +
+	"\x0eprevious_start\x18\x03 \x01(\tR\rpreviousStart\x12!\n" +
+	"\fprevious_end\x18\x04 \x01(\tR\vpreviousEnd\"\xe8\x03\n" +
 	"\rCategoryScore\x12#\n" +
 	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
 	"\x05score\x18\x03 \x01(\x02R\x05score\x12!\n" +
-	"\frating_count\x18\x04 \x01(\x05R\vratingCount\"\\\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12!\n" +
+	"\fperiod_start\x18\x05 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\tR\tperiodEnd\x12%\n" +
+	"\x0esmoothed_score\x18\a \x01(\x02R\rsmoothedScore\x122\n" +
+	"\x15effective_sample_size\x18\b \x01(\x01R\x13effectiveSampleSize\x12L\n" +
+	"\x13confidence_interval\x18\t \x01(\v2\x1b.scoring.ConfidenceIntervalR\x12confidenceInterval\x12#\n" +
+	"\rrolling_score\x18\n" +
+	" \x01(\x02R\frollingScore\x120\n" +
+	"\x14rolling_rating_count\x18\v \x01(\x05R\x12rollingRatingCount\x12#\n" +
+	"\rdecayed_score\x18\f \x01(\x02R\fdecayedScore\"\x94\x01\n" +
 	"\rScoreResponse\x12.\n" +
 	"\x06scores\x18\x01 \x03(\v2\x16.scoring.CategoryScoreR\x06scores\x12\x1b\n" +
-	"\tis_weekly\x18\x02 \x01(\bR\bisWeekly\"\xc0\x01\n" +
+	"\tis_weekly\x18\x02 \x01(\bR\bisWeekly\x126\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2\x14.scoring.GranularityR\vgranularity\"\xc0\x01\n" +
 	"\vTicketScore\x12\x1b\n" +
 	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12Q\n" +
 	"\x0fcategory_scores\x18\x02 \x03(\v2(.scoring.TicketScore.CategoryScoresEntryR\x0ecategoryScores\x1aA\n" +
 	"\x13CategoryScoresEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"P\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"x\n" +
 	"\x13TicketScoreResponse\x129\n" +
-	"\rticket_scores\x18\x01 \x03(\v2\x14.scoring.TicketScoreR\fticketScores\"O\n" +
+	"\rticket_scores\x18\x01 \x03(\v2\x14.scoring.TicketScoreR\fticketScores\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf4\x02\n" +
 	"\x14OverallScoreResponse\x12\x14\n" +
 	"\x05score\x18\x01 \x01(\x02R\x05score\x12!\n" +
-	"\frating_count\x18\x02 \x01(\x05R\vratingCount\"\xdf\x01\n" +
+	"\frating_count\x18\x02 \x01(\x05R\vratingCount\x12%\n" +
+	"\x0esmoothed_score\x18\x03 \x01(\x02R\rsmoothedScore\x122\n" +
+	"\x15effective_sample_size\x18\x04 \x01(\x01R\x13effectiveSampleSize\x12L\n" +
+	"\x13confidence_interval\x18\x05 \x01(\v2\x1b.scoring.ConfidenceIntervalR\x12confidenceInterval\x12#\n" +
+	"\rrolling_score\x18\x06 \x01(\x02R\frollingScore\x120\n" +
+	"\x14rolling_rating_count\x18\a \x01(\x05R\x12rollingRatingCount\x12#\n" +
+	"\rdecayed_score\x18\b \x01(\x02R\fdecayedScore\"\xc3\x04\n" +
 	"\x18PeriodComparisonResponse\x12+\n" +
 	"\x11percentage_change\x18\x01 \x01(\x02R\x10percentageChange\x12#\n" +
 	"\rcurrent_score\x18\x02 \x01(\x02R\fcurrentScore\x12%\n" +
 	"\x0eprevious_score\x18\x03 \x01(\x02R\rpreviousScore\x12#\n" +
 	"\rcurrent_count\x18\x04 \x01(\x05R\fcurrentCount\x12%\n" +
-	"\x0eprevious_count\x18\x05 \x01(\x05R\rpreviousCount2\xc1\x02\n" +
+	"\x0eprevious_count\x18\x05 \x01(\x05R\rpreviousCount\x12F\n" +
+	"\x10current_interval\x18\x06 \x01(\v2\x1b.scoring.ConfidenceIntervalR\x0fcurrentInterval\x12H\n" +
+	"\x11previous_interval\x18\a \x01(\v2\x1b.scoring.ConfidenceIntervalR\x10previousInterval\x12'\n" +
+	"\x0fabsolute_change\x18\b \x01(\x02R\x0eabsoluteChange\x12\x17\n" +
+	"\ap_value\x18\t \x01(\x01R\x06pValue\x12%\n" +
+	"\x0eis_significant\x18\n" +
+	" \x01(\bR\risSignificant\x123\n" +
+	"\bbaseline\x18\v \x01(\x0e2\x17.scoring.BaselineStatusR\bbaseline\x122\n" +
+	"\aperiods\x18\f \x01(\v2\x18.scoring.ResolvedPeriodsR\aperiods\"|\n" +
+	"\x12CategoryComparison\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12A\n" +
+	"\n" +
+	"comparison\x18\x02 \x01(\v2!.scoring.PeriodComparisonResponseR\n" +
+	"comparison\"\x93\x01\n" +
+	" CategoryPeriodComparisonResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.scoring.CategoryComparisonR\n" +
+	"categories\x122\n" +
+	"\aperiods\x18\x02 \x01(\v2\x18.scoring.ResolvedPeriodsR\aperiods\"q\n" +
+	"\x11ScoreTrendRequest\x12-\n" +
+	"\x06period\x18\x01 \x01(\v2\x15.scoring.ScoreRequestR\x06period\x12-\n" +
+	"\x12include_categories\x18\x02 \x01(\bR\x11includeCategories\"\xe9\x01\n" +
+	"\n" +
+	"TrendPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\x05 \x01(\x05R\vratingCount\x12L\n" +
+	"\x13confidence_interval\x18\x06 \x01(\v2\x1b.scoring.ConfidenceIntervalR\x12confidenceInterval\"a\n" +
+	"\rCategoryTrend\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12+\n" +
+	"\x06points\x18\x02 \x03(\v2\x13.scoring.TrendPointR\x06points\"\xb3\x01\n" +
+	"\x12ScoreTrendResponse\x126\n" +
+	"\vgranularity\x18\x01 \x01(\x0e2\x14.scoring.GranularityR\vgranularity\x12-\n" +
+	"\aoverall\x18\x02 \x03(\v2\x13.scoring.TrendPointR\aoverall\x126\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x16.scoring.CategoryTrendR\n" +
+	"categories\"\xf7\x01\n" +
+	"\x14ScoreForecastRequest\x12\x14\n" +
+	"\x05weeks\x18\x01 \x01(\x05R\x05weeks\x12#\n" +
+	"\rhistory_weeks\x18\x02 \x01(\x05R\fhistoryWeeks\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.scoring.TicketFilterR\x06filter\x12)\n" +
+	"\x10confidence_level\x18\x05 \x01(\x01R\x0fconfidenceLevel\x12-\n" +
+	"\x12include_categories\x18\x06 \x01(\bR\x11includeCategories\"\xc9\x01\n" +
+	"\rForecastPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x02R\x05score\x12L\n" +
+	"\x13prediction_interval\x18\x05 \x01(\v2\x1b.scoring.ConfidenceIntervalR\x12predictionInterval\"g\n" +
+	"\x10CategoryForecast\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12.\n" +
+	"\x06points\x18\x02 \x03(\v2\x16.scoring.ForecastPointR\x06points\"\x84\x01\n" +
+	"\x15ScoreForecastResponse\x120\n" +
+	"\aoverall\x18\x01 \x03(\v2\x16.scoring.ForecastPointR\aoverall\x129\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x19.scoring.CategoryForecastR\n" +
+	"categories\"\x84\x01\n" +
+	"\x0eAnomalyRequest\x12-\n" +
+	"\x06period\x18\x01 \x01(\v2\x15.scoring.ScoreRequestR\x06period\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x01R\tthreshold\x12%\n" +
+	"\x0ebaseline_weeks\x18\x03 \x01(\x05R\rbaselineWeeks\"\xe4\x02\n" +
+	"\aAnomaly\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12%\n" +
+	"\x0eexpected_score\x18\x05 \x01(\x02R\rexpectedScore\x12%\n" +
+	"\x0eexpected_lower\x18\x06 \x01(\x02R\rexpectedLower\x12%\n" +
+	"\x0eexpected_upper\x18\a \x01(\x02R\rexpectedUpper\x12\x17\n" +
+	"\az_score\x18\b \x01(\x01R\x06zScore\x124\n" +
+	"\bseverity\x18\t \x01(\x0e2\x18.scoring.AnomalySeverityR\bseverity\x12#\n" +
+	"\rbaseline_days\x18\n" +
+	" \x01(\x05R\fbaselineDays\"A\n" +
+	"\x0fAnomalyResponse\x12.\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x10.scoring.AnomalyR\tanomalies\"\xa1\x02\n" +
+	"\x12LeaderboardRequest\x12-\n" +
+	"\x06period\x18\x01 \x01(\v2\x15.scoring.ScoreRequestR\x06period\x12>\n" +
+	"\x0fprevious_period\x18\x02 \x01(\v2\x15.scoring.ScoreRequestR\x0epreviousPeriod\x12;\n" +
+	"\tdimension\x18\x03 \x01(\x0e2\x1d.scoring.LeaderboardDimensionR\tdimension\x12(\n" +
+	"\x10min_rating_count\x18\x04 \x01(\x05R\x0eminRatingCount\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vworst_first\x18\x06 \x01(\bR\n" +
+	"worstFirst\"x\n" +
+	"\x18LeaderboardCategoryScore\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\x03 \x01(\x05R\vratingCount\"\xfa\x01\n" +
+	"\x10LeaderboardEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12A\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\v2!.scoring.LeaderboardCategoryScoreR\n" +
+	"categories\x12#\n" +
+	"\rprevious_rank\x18\x06 \x01(\x05R\fpreviousRank\x12\x1f\n" +
+	"\vrank_change\x18\a \x01(\x05R\n" +
+	"rankChange\"\xae\x01\n" +
+	"\x13LeaderboardResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.scoring.LeaderboardEntryR\aentries\x122\n" +
+	"\x15previous_period_start\x18\x02 \x01(\tR\x13previousPeriodStart\x12.\n" +
+	"\x13previous_period_end\x18\x03 \x01(\tR\x11previousPeriodEnd\"\xd9\x01\n" +
+	"\x13SubmitRatingRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12,\n" +
+	"\x12rating_category_id\x18\x02 \x01(\x05R\x10ratingCategoryId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x1f\n" +
+	"\vreviewer_id\x18\x04 \x01(\x05R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreviewee_id\x18\x05 \x01(\x05R\n" +
+	"revieweeId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"3\n" +
+	"\x14SubmitRatingResponse\x12\x1b\n" +
+	"\trating_id\x18\x01 \x01(\x03R\bratingId\"S\n" +
+	"\x19BatchSubmitRatingsRequest\x126\n" +
+	"\aratings\x18\x01 \x03(\v2\x1c.scoring.SubmitRatingRequestR\aratings\"W\n" +
+	"\fRatingResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1b\n" +
+	"\trating_id\x18\x02 \x01(\x03R\bratingId\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x9b\x01\n" +
+	"\x1aBatchSubmitRatingsResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.scoring.RatingResultR\aresults\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x05R\racceptedCount\x12%\n" +
+	"\x0erejected_count\x18\x03 \x01(\x05R\rrejectedCount\"r\n" +
+	"\x0eCategoryWeight\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x01R\x06weight\x12%\n" +
+	"\x0eeffective_from\x18\x02 \x01(\tR\reffectiveFrom\x12!\n" +
+	"\feffective_to\x18\x03 \x01(\tR\veffectiveTo\"\xc9\x01\n" +
+	"\x0eRatingCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12\x1f\n" +
+	"\varchived_at\x18\x05 \x01(\tR\n" +
+	"archivedAt\x12>\n" +
+	"\x0eweight_history\x18\x06 \x03(\v2\x17.scoring.CategoryWeightR\rweightHistory\"x\n" +
+	"\x15ListCategoriesRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\x124\n" +
+	"\x16include_weight_history\x18\x02 \x01(\bR\x14includeWeightHistory\"Q\n" +
+	"\x16ListCategoriesResponse\x127\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x17.scoring.RatingCategoryR\n" +
+	"categories\"j\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12%\n" +
+	"\x0eeffective_from\x18\x03 \x01(\tR\reffectiveFrom\"L\n" +
+	"\x15RenameCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"}\n" +
+	"\x1bUpdateCategoryWeightRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12%\n" +
+	"\x0eeffective_from\x18\x03 \x01(\tR\reffectiveFrom\"9\n" +
+	"\x16ArchiveCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId*\x94\x01\n" +
+	"\vGranularity\x12\x14\n" +
+	"\x10GRANULARITY_AUTO\x10\x00\x12\x13\n" +
+	"\x0fGRANULARITY_DAY\x10\x01\x12\x14\n" +
+	"\x10GRANULARITY_WEEK\x10\x02\x12\x15\n" +
+	"\x11GRANULARITY_MONTH\x10\x03\x12\x17\n" +
+	"\x13GRANULARITY_QUARTER\x10\x04\x12\x14\n" +
+	"\x10GRANULARITY_YEAR\x10\x05*S\n" +
+	"\rSmoothingMode\x12\x12\n" +
+	"\x0eSMOOTHING_NONE\x10\x00\x12\x13\n" +
+	"\x0fSMOOTHING_PRIOR\x10\x01\x12\x19\n" +
+	"\x15SMOOTHING_GLOBAL_MEAN\x10\x02*\xe9\x01\n" +
+	"\fPeriodPreset\x12\x16\n" +
+	"\x12PERIOD_PRESET_NONE\x10\x00\x12\x1d\n" +
+	"\x19PERIOD_PRESET_LAST_7_DAYS\x10\x01\x12\x1e\n" +
+	"\x1aPERIOD_PRESET_WEEK_TO_DATE\x10\x02\x12\x1f\n" +
+	"\x1bPERIOD_PRESET_MONTH_TO_DATE\x10\x03\x12!\n" +
+	"\x1dPERIOD_PRESET_QUARTER_TO_DATE\x10\x04\x12\x1e\n" +
+	"\x1aPERIOD_PRESET_YEAR_TO_DATE\x10\x05\x12\x1e\n" +
+	"\x1aPERIOD_PRESET_ROLLING_DAYS\x10\x06*K\n" +
+	"\tCompareTo\x12\x18\n" +
+	"\x14COMPARE_TO_PRECEDING\x10\x00\x12$\n" +
+	" COMPARE_TO_SAME_PERIOD_LAST_YEAR\x10\x01*S\n" +
+	"\x0eBaselineStatus\x12\x0f\n" +
+	"\vBASELINE_OK\x10\x00\x12\x17\n" +
+	"\x13BASELINE_NO_RATINGS\x10\x01\x12\x17\n" +
+	"\x13BASELINE_ZERO_SCORE\x10\x02*h\n" +
+	"\x0fAnomalySeverity\x12\x1a\n" +
+	"\x16ANOMALY_SEVERITY_MINOR\x10\x00\x12\x1a\n" +
+	"\x16ANOMALY_SEVERITY_MAJOR\x10\x01\x12\x1d\n" +
+	"\x19ANOMALY_SEVERITY_CRITICAL\x10\x02*W\n" +
+	"\x14LeaderboardDimension\x12\x1f\n" +
+	"\x1bLEADERBOARD_DIMENSION_AGENT\x10\x00\x12\x1e\n" +
+	"\x1aLEADERBOARD_DIMENSION_TEAM\x10\x012\xd8\n" +
+	"\n" +
 	"\x0eScoringService\x12B\n" +
 	"\x11GetCategoryScores\x12\x15.scoring.ScoreRequest\x1a\x16.scoring.ScoreResponse\x12F\n" +
-	"\x0fGetTicketScores\x12\x15.scoring.ScoreRequest\x1a\x1c.scoring.TicketScoreResponse\x12G\n" +
+	"\x0fGetTicketScores\x12\x15.scoring.ScoreRequest\x1a\x1c.scoring.TicketScoreResponse\x12C\n" +
+	"\x12StreamTicketScores\x12\x15.scoring.ScoreRequest\x1a\x14.scoring.TicketScore0\x01\x12G\n" +
 	"\x0fGetOverallScore\x12\x15.scoring.ScoreRequest\x1a\x1d.scoring.OverallScoreResponse\x12Z\n" +
-	"\x13GetPeriodComparison\x12 .scoring.PeriodComparisonRequest\x1a!.scoring.PeriodComparisonResponseB)Z'ticket-score-engine/generated/scoringpbb\x06proto3"
+	"\x13GetPeriodComparison\x12 .scoring.PeriodComparisonRequest\x1a!.scoring.PeriodComparisonResponse\x12j\n" +
+	"\x1bGetCategoryPeriodComparison\x12 .scoring.PeriodComparisonRequest\x1a).scoring.CategoryPeriodComparisonResponse\x12H\n" +
+	"\rGetScoreTrend\x12\x1a.scoring.ScoreTrendRequest\x1a\x1b.scoring.ScoreTrendResponse\x12A\n" +
+	"\fGetAnomalies\x12\x17.scoring.AnomalyRequest\x1a\x18.scoring.AnomalyResponse\x12Q\n" +
+	"\x10GetScoreForecast\x12\x1d.scoring.ScoreForecastRequest\x1a\x1e.scoring.ScoreForecastResponse\x12K\n" +
+	"\x0eGetLeaderboard\x12\x1b.scoring.LeaderboardRequest\x1a\x1c.scoring.LeaderboardResponse\x12K\n" +
+	"\fSubmitRating\x12\x1c.scoring.SubmitRatingRequest\x1a\x1d.scoring.SubmitRatingResponse\x12]\n" +
+	"\x12BatchSubmitRatings\x12\".scoring.BatchSubmitRatingsRequest\x1a#.scoring.BatchSubmitRatingsResponse\x12Q\n" +
+	"\x0eListCategories\x12\x1e.scoring.ListCategoriesRequest\x1a\x1f.scoring.ListCategoriesResponse\x12I\n" +
+	"\x0eCreateCategory\x12\x1e.scoring.CreateCategoryRequest\x1a\x17.scoring.RatingCategory\x12I\n" +
+	"\x0eRenameCategory\x12\x1e.scoring.RenameCategoryRequest\x1a\x17.scoring.RatingCategory\x12U\n" +
+	"\x14UpdateCategoryWeight\x12$.scoring.UpdateCategoryWeightRequest\x1a\x17.scoring.RatingCategory\x12K\n" +
+	"\x0fArchiveCategory\x12\x1f.scoring.ArchiveCategoryRequest\x1a\x17.scoring.RatingCategoryB)Z'ticket-score-engine/generated/scoringpbb\x06proto3"
 
 var (
 	file_scoring_proto_rawDescOnce sync.Once
@@ -530,37 +3641,144 @@ func file_scoring_proto_rawDescGZIP() []byte {
 	return file_scoring_proto_rawDescData
 }
 
-var file_scoring_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_scoring_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_scoring_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_scoring_proto_goTypes = []any{
-	(*ScoreRequest)(nil),             // 0: scoring.ScoreRequest
-	(*PeriodComparisonRequest)(nil),  // 1: scoring.PeriodComparisonRequest
-	(*CategoryScore)(nil),            // 2: scoring.CategoryScore
-	(*ScoreResponse)(nil),            // 3: scoring.ScoreResponse
-	(*TicketScore)(nil),              // 4: scoring.TicketScore
-	(*TicketScoreResponse)(nil),      // 5: scoring.TicketScoreResponse
-	(*OverallScoreResponse)(nil),     // 6: scoring.OverallScoreResponse
-	(*PeriodComparisonResponse)(nil), // 7: scoring.PeriodComparisonResponse
-	nil,                              // 8: scoring.TicketScore.CategoryScoresEntry
+	(Granularity)(0),                         // 0: scoring.Granularity
+	(SmoothingMode)(0),                       // 1: scoring.SmoothingMode
+	(PeriodPreset)(0),                        // 2: scoring.PeriodPreset
+	(CompareTo)(0),                           // 3: scoring.CompareTo
+	(BaselineStatus)(0),                      // 4: scoring.BaselineStatus
+	(AnomalySeverity)(0),                     // 5: scoring.AnomalySeverity
+	(LeaderboardDimension)(0),                // 6: scoring.LeaderboardDimension
+	(*ScoreRequest)(nil),                     // 7: scoring.ScoreRequest
+	(*Smoothing)(nil),                        // 8: scoring.Smoothing
+	(*ConfidenceInterval)(nil),               // 9: scoring.ConfidenceInterval
+	(*TicketFilter)(nil),                     // 10: scoring.TicketFilter
+	(*PeriodComparisonRequest)(nil),          // 11: scoring.PeriodComparisonRequest
+	(*ResolvedPeriods)(nil),                  // 12: scoring.ResolvedPeriods
+	(*CategoryScore)(nil),                    // 13: scoring.CategoryScore
+	(*ScoreResponse)(nil),                    // 14: scoring.ScoreResponse
+	(*TicketScore)(nil),                      // 15: scoring.TicketScore
+	(*TicketScoreResponse)(nil),              // 16: scoring.TicketScoreResponse
+	(*OverallScoreResponse)(nil),             // 17: scoring.OverallScoreResponse
+	(*PeriodComparisonResponse)(nil),         // 18: scoring.PeriodComparisonResponse
+	(*CategoryComparison)(nil),               // 19: scoring.CategoryComparison
+	(*CategoryPeriodComparisonResponse)(nil), // 20: scoring.CategoryPeriodComparisonResponse
+	(*ScoreTrendRequest)(nil),                // 21: scoring.ScoreTrendRequest
+	(*TrendPoint)(nil),                       // 22: scoring.TrendPoint
+	(*CategoryTrend)(nil),                    // 23: scoring.CategoryTrend
+	(*ScoreTrendResponse)(nil),               // 24: scoring.ScoreTrendResponse
+	(*ScoreForecastRequest)(nil),             // 25: scoring.ScoreForecastRequest
+	(*ForecastPoint)(nil),                    // 26: scoring.ForecastPoint
+	(*CategoryForecast)(nil),                 // 27: scoring.CategoryForecast
+	(*ScoreForecastResponse)(nil),            // 28: scoring.ScoreForecastResponse
+	(*AnomalyRequest)(nil),                   // 29: scoring.AnomalyRequest
+	(*Anomaly)(nil),                          // 30: scoring.Anomaly
+	(*AnomalyResponse)(nil),                  // 31: scoring.AnomalyResponse
+	(*LeaderboardRequest)(nil),               // 32: scoring.LeaderboardRequest
+	(*LeaderboardCategoryScore)(nil),         // 33: scoring.LeaderboardCategoryScore
+	(*LeaderboardEntry)(nil),                 // 34: scoring.LeaderboardEntry
+	(*LeaderboardResponse)(nil),              // 35: scoring.LeaderboardResponse
+	(*SubmitRatingRequest)(nil),              // 36: scoring.SubmitRatingRequest
+	(*SubmitRatingResponse)(nil),             // 37: scoring.SubmitRatingResponse
+	(*BatchSubmitRatingsRequest)(nil),        // 38: scoring.BatchSubmitRatingsRequest
+	(*RatingResult)(nil),                     // 39: scoring.RatingResult
+	(*BatchSubmitRatingsResponse)(nil),       // 40: scoring.BatchSubmitRatingsResponse
+	(*CategoryWeight)(nil),                   // 41: scoring.CategoryWeight
+	(*RatingCategory)(nil),                   // 42: scoring.RatingCategory
+	(*ListCategoriesRequest)(nil),            // 43: scoring.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),           // 44: scoring.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),            // 45: scoring.CreateCategoryRequest
+	(*RenameCategoryRequest)(nil),            // 46: scoring.RenameCategoryRequest
+	(*UpdateCategoryWeightRequest)(nil),      // 47: scoring.UpdateCategoryWeightRequest
+	(*ArchiveCategoryRequest)(nil),           // 48: scoring.ArchiveCategoryRequest
+	nil,                                      // 49: scoring.TicketScore.CategoryScoresEntry
 }
 var file_scoring_proto_depIdxs = []int32{
-	0, // 0: scoring.PeriodComparisonRequest.current_period:type_name -> scoring.ScoreRequest
-	0, // 1: scoring.PeriodComparisonRequest.previous_period:type_name -> scoring.ScoreRequest
-	2, // 2: scoring.ScoreResponse.scores:type_name -> scoring.CategoryScore
-	8, // 3: scoring.TicketScore.category_scores:type_name -> scoring.TicketScore.CategoryScoresEntry
-	4, // 4: scoring.TicketScoreResponse.ticket_scores:type_name -> scoring.TicketScore
-	0, // 5: scoring.ScoringService.GetCategoryScores:input_type -> scoring.ScoreRequest
-	0, // 6: scoring.ScoringService.GetTicketScores:input_type -> scoring.ScoreRequest
-	0, // 7: scoring.ScoringService.GetOverallScore:input_type -> scoring.ScoreRequest
-	1, // 8: scoring.ScoringService.GetPeriodComparison:input_type -> scoring.PeriodComparisonRequest
-	3, // 9: scoring.ScoringService.GetCategoryScores:output_type -> scoring.ScoreResponse
-	5, // 10: scoring.ScoringService.GetTicketScores:output_type -> scoring.TicketScoreResponse
-	6, // 11: scoring.ScoringService.GetOverallScore:output_type -> scoring.OverallScoreResponse
-	7, // 12: scoring.ScoringService.GetPeriodComparison:output_type -> scoring.PeriodComparisonResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: scoring.ScoreRequest.granularity:type_name -> scoring.Granularity
+	10, // 1: scoring.ScoreRequest.filter:type_name -> scoring.TicketFilter
+	8,  // 2: scoring.ScoreRequest.smoothing:type_name -> scoring.Smoothing
+	1,  // 3: scoring.Smoothing.mode:type_name -> scoring.SmoothingMode
+	7,  // 4: scoring.PeriodComparisonRequest.current_period:type_name -> scoring.ScoreRequest
+	7,  // 5: scoring.PeriodComparisonRequest.previous_period:type_name -> scoring.ScoreRequest
+	2,  // 6: scoring.PeriodComparisonRequest.preset:type_name -> scoring.PeriodPreset
+	3,  // 7: scoring.PeriodComparisonRequest.compare_to:type_name -> scoring.CompareTo
+	9,  // 8: scoring.CategoryScore.confidence_interval:type_name -> scoring.ConfidenceInterval
+	13, // 9: scoring.ScoreResponse.scores:type_name -> scoring.CategoryScore
+	0,  // 10: scoring.ScoreResponse.granularity:type_name -> scoring.Granularity
+	49, // 11: scoring.TicketScore.category_scores:type_name -> scoring.TicketScore.CategoryScoresEntry
+	15, // 12: scoring.TicketScoreResponse.ticket_scores:type_name -> scoring.TicketScore
+	9,  // 13: scoring.OverallScoreResponse.confidence_interval:type_name -> scoring.ConfidenceInterval
+	9,  // 14: scoring.PeriodComparisonResponse.current_interval:type_name -> scoring.ConfidenceInterval
+	9,  // 15: scoring.PeriodComparisonResponse.previous_interval:type_name -> scoring.ConfidenceInterval
+	4,  // 16: scoring.PeriodComparisonResponse.baseline:type_name -> scoring.BaselineStatus
+	12, // 17: scoring.PeriodComparisonResponse.periods:type_name -> scoring.ResolvedPeriods
+	18, // 18: scoring.CategoryComparison.comparison:type_name -> scoring.PeriodComparisonResponse
+	19, // 19: scoring.CategoryPeriodComparisonResponse.categories:type_name -> scoring.CategoryComparison
+	12, // 20: scoring.CategoryPeriodComparisonResponse.periods:type_name -> scoring.ResolvedPeriods
+	7,  // 21: scoring.ScoreTrendRequest.period:type_name -> scoring.ScoreRequest
+	9,  // 22: scoring.TrendPoint.confidence_interval:type_name -> scoring.ConfidenceInterval
+	22, // 23: scoring.CategoryTrend.points:type_name -> scoring.TrendPoint
+	0,  // 24: scoring.ScoreTrendResponse.granularity:type_name -> scoring.Granularity
+	22, // 25: scoring.ScoreTrendResponse.overall:type_name -> scoring.TrendPoint
+	23, // 26: scoring.ScoreTrendResponse.categories:type_name -> scoring.CategoryTrend
+	10, // 27: scoring.ScoreForecastRequest.filter:type_name -> scoring.TicketFilter
+	9,  // 28: scoring.ForecastPoint.prediction_interval:type_name -> scoring.ConfidenceInterval
+	26, // 29: scoring.CategoryForecast.points:type_name -> scoring.ForecastPoint
+	26, // 30: scoring.ScoreForecastResponse.overall:type_name -> scoring.ForecastPoint
+	27, // 31: scoring.ScoreForecastResponse.categories:type_name -> scoring.CategoryForecast
+	7,  // 32: scoring.AnomalyRequest.period:type_name -> scoring.ScoreRequest
+	5,  // 33: scoring.Anomaly.severity:type_name -> scoring.AnomalySeverity
+	30, // 34: scoring.AnomalyResponse.anomalies:type_name -> scoring.Anomaly
+	7,  // 35: scoring.LeaderboardRequest.period:type_name -> scoring.ScoreRequest
+	7,  // 36: scoring.LeaderboardRequest.previous_period:type_name -> scoring.ScoreRequest
+	6,  // 37: scoring.LeaderboardRequest.dimension:type_name -> scoring.LeaderboardDimension
+	33, // 38: scoring.LeaderboardEntry.categories:type_name -> scoring.LeaderboardCategoryScore
+	34, // 39: scoring.LeaderboardResponse.entries:type_name -> scoring.LeaderboardEntry
+	36, // 40: scoring.BatchSubmitRatingsRequest.ratings:type_name -> scoring.SubmitRatingRequest
+	39, // 41: scoring.BatchSubmitRatingsResponse.results:type_name -> scoring.RatingResult
+	41, // 42: scoring.RatingCategory.weight_history:type_name -> scoring.CategoryWeight
+	42, // 43: scoring.ListCategoriesResponse.categories:type_name -> scoring.RatingCategory
+	7,  // 44: scoring.ScoringService.GetCategoryScores:input_type -> scoring.ScoreRequest
+	7,  // 45: scoring.ScoringService.GetTicketScores:input_type -> scoring.ScoreRequest
+	7,  // 46: scoring.ScoringService.StreamTicketScores:input_type -> scoring.ScoreRequest
+	7,  // 47: scoring.ScoringService.GetOverallScore:input_type -> scoring.ScoreRequest
+	11, // 48: scoring.ScoringService.GetPeriodComparison:input_type -> scoring.PeriodComparisonRequest
+	11, // 49: scoring.ScoringService.GetCategoryPeriodComparison:input_type -> scoring.PeriodComparisonRequest
+	21, // 50: scoring.ScoringService.GetScoreTrend:input_type -> scoring.ScoreTrendRequest
+	29, // 51: scoring.ScoringService.GetAnomalies:input_type -> scoring.AnomalyRequest
+	25, // 52: scoring.ScoringService.GetScoreForecast:input_type -> scoring.ScoreForecastRequest
+	32, // 53: scoring.ScoringService.GetLeaderboard:input_type -> scoring.LeaderboardRequest
+	36, // 54: scoring.ScoringService.SubmitRating:input_type -> scoring.SubmitRatingRequest
+	38, // 55: scoring.ScoringService.BatchSubmitRatings:input_type -> scoring.BatchSubmitRatingsRequest
+	43, // 56: scoring.ScoringService.ListCategories:input_type -> scoring.ListCategoriesRequest
+	45, // 57: scoring.ScoringService.CreateCategory:input_type -> scoring.CreateCategoryRequest
+	46, // 58: scoring.ScoringService.RenameCategory:input_type -> scoring.RenameCategoryRequest
+	47, // 59: scoring.ScoringService.UpdateCategoryWeight:input_type -> scoring.UpdateCategoryWeightRequest
+	48, // 60: scoring.ScoringService.ArchiveCategory:input_type -> scoring.ArchiveCategoryRequest
+	14, // 61: scoring.ScoringService.GetCategoryScores:output_type -> scoring.ScoreResponse
+	16, // 62: scoring.ScoringService.GetTicketScores:output_type -> scoring.TicketScoreResponse
+	15, // 63: scoring.ScoringService.StreamTicketScores:output_type -> scoring.TicketScore
+	17, // 64: scoring.ScoringService.GetOverallScore:output_type -> scoring.OverallScoreResponse
+	18, // 65: scoring.ScoringService.GetPeriodComparison:output_type -> scoring.PeriodComparisonResponse
+	20, // 66: scoring.ScoringService.GetCategoryPeriodComparison:output_type -> scoring.CategoryPeriodComparisonResponse
+	24, // 67: scoring.ScoringService.GetScoreTrend:output_type -> scoring.ScoreTrendResponse
+	31, // 68: scoring.ScoringService.GetAnomalies:output_type -> scoring.AnomalyResponse
+	28, // 69: scoring.ScoringService.GetScoreForecast:output_type -> scoring.ScoreForecastResponse
+	35, // 70: scoring.ScoringService.GetLeaderboard:output_type -> scoring.LeaderboardResponse
+	37, // 71: scoring.ScoringService.SubmitRating:output_type -> scoring.SubmitRatingResponse
+	40, // 72: scoring.ScoringService.BatchSubmitRatings:output_type -> scoring.BatchSubmitRatingsResponse
+	44, // 73: scoring.ScoringService.ListCategories:output_type -> scoring.ListCategoriesResponse
+	42, // 74: scoring.ScoringService.CreateCategory:output_type -> scoring.RatingCategory
+	42, // 75: scoring.ScoringService.RenameCategory:output_type -> scoring.RatingCategory
+	42, // 76: scoring.ScoringService.UpdateCategoryWeight:output_type -> scoring.RatingCategory
+	42, // 77: scoring.ScoringService.ArchiveCategory:output_type -> scoring.RatingCategory
+	61, // [61:78] is the sub-list for method output_type
+	44, // [44:61] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_scoring_proto_init() }
@@ -573,13 +3791,14 @@ func file_scoring_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scoring_proto_rawDesc), len(file_scoring_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      7,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_scoring_proto_goTypes,
 		DependencyIndexes: file_scoring_proto_depIdxs,
+		EnumInfos:         file_scoring_proto_enumTypes,
 		MessageInfos:      file_scoring_proto_msgTypes,
 	}.Build()
 	File_scoring_proto = out.File
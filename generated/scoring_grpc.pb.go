@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ScoringService_GetCategoryScores_FullMethodName   = "/scoring.ScoringService/GetCategoryScores"
-	ScoringService_GetTicketScores_FullMethodName     = "/scoring.ScoringService/GetTicketScores"
-	ScoringService_GetOverallScore_FullMethodName     = "/scoring.ScoringService/GetOverallScore"
-	ScoringService_GetPeriodComparison_FullMethodName = "/scoring.ScoringService/GetPeriodComparison"
+	ScoringService_GetCategoryScores_FullMethodName           = "/scoring.ScoringService/GetCategoryScores"
+	ScoringService_GetTicketScores_FullMethodName             = "/scoring.ScoringService/GetTicketScores"
+	ScoringService_StreamTicketScores_FullMethodName          = "/scoring.ScoringService/StreamTicketScores"
+	ScoringService_GetOverallScore_FullMethodName             = "/scoring.ScoringService/GetOverallScore"
+	ScoringService_GetPeriodComparison_FullMethodName         = "/scoring.ScoringService/GetPeriodComparison"
+	ScoringService_GetCategoryPeriodComparison_FullMethodName = "/scoring.ScoringService/GetCategoryPeriodComparison"
+	ScoringService_GetScoreTrend_FullMethodName               = "/scoring.ScoringService/GetScoreTrend"
+	ScoringService_GetAnomalies_FullMethodName                = "/scoring.ScoringService/GetAnomalies"
+	ScoringService_GetScoreForecast_FullMethodName            = "/scoring.ScoringService/GetScoreForecast"
+	ScoringService_GetLeaderboard_FullMethodName              = "/scoring.ScoringService/GetLeaderboard"
+	ScoringService_SubmitRating_FullMethodName                = "/scoring.ScoringService/SubmitRating"
+	ScoringService_BatchSubmitRatings_FullMethodName          = "/scoring.ScoringService/BatchSubmitRatings"
+	ScoringService_ListCategories_FullMethodName              = "/scoring.ScoringService/ListCategories"
+	ScoringService_CreateCategory_FullMethodName              = "/scoring.ScoringService/CreateCategory"
+	ScoringService_RenameCategory_FullMethodName              = "/scoring.ScoringService/RenameCategory"
+	ScoringService_UpdateCategoryWeight_FullMethodName        = "/scoring.ScoringService/UpdateCategoryWeight"
+	ScoringService_ArchiveCategory_FullMethodName             = "/scoring.ScoringService/ArchiveCategory"
 )
 
 // ScoringServiceClient is the client API for ScoringService service.
@@ -31,8 +44,21 @@ const (
 type ScoringServiceClient interface {
 	GetCategoryScores(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*ScoreResponse, error)
 	GetTicketScores(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*TicketScoreResponse, error)
+	StreamTicketScores(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TicketScore], error)
 	GetOverallScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*OverallScoreResponse, error)
 	GetPeriodComparison(ctx context.Context, in *PeriodComparisonRequest, opts ...grpc.CallOption) (*PeriodComparisonResponse, error)
+	GetCategoryPeriodComparison(ctx context.Context, in *PeriodComparisonRequest, opts ...grpc.CallOption) (*CategoryPeriodComparisonResponse, error)
+	GetScoreTrend(ctx context.Context, in *ScoreTrendRequest, opts ...grpc.CallOption) (*ScoreTrendResponse, error)
+	GetAnomalies(ctx context.Context, in *AnomalyRequest, opts ...grpc.CallOption) (*AnomalyResponse, error)
+	GetScoreForecast(ctx context.Context, in *ScoreForecastRequest, opts ...grpc.CallOption) (*ScoreForecastResponse, error)
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
+	SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingResponse, error)
+	BatchSubmitRatings(ctx context.Context, in *BatchSubmitRatingsRequest, opts ...grpc.CallOption) (*BatchSubmitRatingsResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
+	RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
+	UpdateCategoryWeight(ctx context.Context, in *UpdateCategoryWeightRequest, opts ...grpc.CallOption) (*RatingCategory, error)
+	ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
 }
 
 type scoringServiceClient struct {
@@ -63,6 +89,25 @@ func (c *scoringServiceClient) GetTicketScores(ctx context.Context, in *ScoreReq
 	return out, nil
 }
 
+func (c *scoringServiceClient) StreamTicketScores(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TicketScore], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoringService_ServiceDesc.Streams[0], ScoringService_StreamTicketScores_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScoreRequest, TicketScore]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_StreamTicketScoresClient = grpc.ServerStreamingClient[TicketScore]
+
 func (c *scoringServiceClient) GetOverallScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*OverallScoreResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OverallScoreResponse)
@@ -83,14 +128,147 @@ func (c *scoringServiceClient) GetPeriodComparison(ctx context.Context, in *Peri
 	return out, nil
 }
 
+func (c *scoringServiceClient) GetCategoryPeriodComparison(ctx context.Context, in *PeriodComparisonRequest, opts ...grpc.CallOption) (*CategoryPeriodComparisonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryPeriodComparisonResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetCategoryPeriodComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetScoreTrend(ctx context.Context, in *ScoreTrendRequest, opts ...grpc.CallOption) (*ScoreTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreTrendResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetScoreTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetAnomalies(ctx context.Context, in *AnomalyRequest, opts ...grpc.CallOption) (*AnomalyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetScoreForecast(ctx context.Context, in *ScoreForecastRequest, opts ...grpc.CallOption) (*ScoreForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreForecastResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetScoreForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, ScoringService_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitRatingResponse)
+	err := c.cc.Invoke(ctx, ScoringService_SubmitRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) BatchSubmitRatings(ctx context.Context, in *BatchSubmitRatingsRequest, opts ...grpc.CallOption) (*BatchSubmitRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSubmitRatingsResponse)
+	err := c.cc.Invoke(ctx, ScoringService_BatchSubmitRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ScoringService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, ScoringService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, ScoringService_RenameCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) UpdateCategoryWeight(ctx context.Context, in *UpdateCategoryWeightRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, ScoringService_UpdateCategoryWeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, ScoringService_ArchiveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScoringServiceServer is the server API for ScoringService service.
 // All implementations must embed UnimplementedScoringServiceServer
 // for forward compatibility.
 type ScoringServiceServer interface {
 	GetCategoryScores(context.Context, *ScoreRequest) (*ScoreResponse, error)
 	GetTicketScores(context.Context, *ScoreRequest) (*TicketScoreResponse, error)
+	StreamTicketScores(*ScoreRequest, grpc.ServerStreamingServer[TicketScore]) error
 	GetOverallScore(context.Context, *ScoreRequest) (*OverallScoreResponse, error)
 	GetPeriodComparison(context.Context, *PeriodComparisonRequest) (*PeriodComparisonResponse, error)
+	GetCategoryPeriodComparison(context.Context, *PeriodComparisonRequest) (*CategoryPeriodComparisonResponse, error)
+	GetScoreTrend(context.Context, *ScoreTrendRequest) (*ScoreTrendResponse, error)
+	GetAnomalies(context.Context, *AnomalyRequest) (*AnomalyResponse, error)
+	GetScoreForecast(context.Context, *ScoreForecastRequest) (*ScoreForecastResponse, error)
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error)
+	SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingResponse, error)
+	BatchSubmitRatings(context.Context, *BatchSubmitRatingsRequest) (*BatchSubmitRatingsResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*RatingCategory, error)
+	RenameCategory(context.Context, *RenameCategoryRequest) (*RatingCategory, error)
+	UpdateCategoryWeight(context.Context, *UpdateCategoryWeightRequest) (*RatingCategory, error)
+	ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*RatingCategory, error)
 	mustEmbedUnimplementedScoringServiceServer()
 }
 
@@ -107,12 +285,51 @@ func (UnimplementedScoringServiceServer) GetCategoryScores(context.Context, *Sco
 func (UnimplementedScoringServiceServer) GetTicketScores(context.Context, *ScoreRequest) (*TicketScoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicketScores not implemented")
 }
+func (UnimplementedScoringServiceServer) StreamTicketScores(*ScoreRequest, grpc.ServerStreamingServer[TicketScore]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTicketScores not implemented")
+}
 func (UnimplementedScoringServiceServer) GetOverallScore(context.Context, *ScoreRequest) (*OverallScoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOverallScore not implemented")
 }
 func (UnimplementedScoringServiceServer) GetPeriodComparison(context.Context, *PeriodComparisonRequest) (*PeriodComparisonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeriodComparison not implemented")
 }
+func (UnimplementedScoringServiceServer) GetCategoryPeriodComparison(context.Context, *PeriodComparisonRequest) (*CategoryPeriodComparisonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryPeriodComparison not implemented")
+}
+func (UnimplementedScoringServiceServer) GetScoreTrend(context.Context, *ScoreTrendRequest) (*ScoreTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreTrend not implemented")
+}
+func (UnimplementedScoringServiceServer) GetAnomalies(context.Context, *AnomalyRequest) (*AnomalyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedScoringServiceServer) GetScoreForecast(context.Context, *ScoreForecastRequest) (*ScoreForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreForecast not implemented")
+}
+func (UnimplementedScoringServiceServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedScoringServiceServer) SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRating not implemented")
+}
+func (UnimplementedScoringServiceServer) BatchSubmitRatings(context.Context, *BatchSubmitRatingsRequest) (*BatchSubmitRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSubmitRatings not implemented")
+}
+func (UnimplementedScoringServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedScoringServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedScoringServiceServer) RenameCategory(context.Context, *RenameCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCategory not implemented")
+}
+func (UnimplementedScoringServiceServer) UpdateCategoryWeight(context.Context, *UpdateCategoryWeightRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryWeight not implemented")
+}
+func (UnimplementedScoringServiceServer) ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCategory not implemented")
+}
 func (UnimplementedScoringServiceServer) mustEmbedUnimplementedScoringServiceServer() {}
 func (UnimplementedScoringServiceServer) testEmbeddedByValue()                        {}
 
@@ -170,6 +387,17 @@ func _ScoringService_GetTicketScores_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScoringService_StreamTicketScores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScoreRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoringServiceServer).StreamTicketScores(m, &grpc.GenericServerStream[ScoreRequest, TicketScore]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoringService_StreamTicketScoresServer = grpc.ServerStreamingServer[TicketScore]
+
 func _ScoringService_GetOverallScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScoreRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +434,222 @@ func _ScoringService_GetPeriodComparison_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScoringService_GetCategoryPeriodComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetCategoryPeriodComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetCategoryPeriodComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetCategoryPeriodComparison(ctx, req.(*PeriodComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetScoreTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetScoreTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetScoreTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetScoreTrend(ctx, req.(*ScoreTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnomalyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetAnomalies(ctx, req.(*AnomalyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetScoreForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetScoreForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetScoreForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetScoreForecast(ctx, req.(*ScoreForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_SubmitRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).SubmitRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_SubmitRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).SubmitRating(ctx, req.(*SubmitRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_BatchSubmitRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSubmitRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).BatchSubmitRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_BatchSubmitRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).BatchSubmitRatings(ctx, req.(*BatchSubmitRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_RenameCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).RenameCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_RenameCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).RenameCategory(ctx, req.(*RenameCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_UpdateCategoryWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).UpdateCategoryWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_UpdateCategoryWeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).UpdateCategoryWeight(ctx, req.(*UpdateCategoryWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_ArchiveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).ArchiveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_ArchiveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).ArchiveCategory(ctx, req.(*ArchiveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScoringService_ServiceDesc is the grpc.ServiceDesc for ScoringService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +673,61 @@ var ScoringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeriodComparison",
 			Handler:    _ScoringService_GetPeriodComparison_Handler,
 		},
+		{
+			MethodName: "GetCategoryPeriodComparison",
+			Handler:    _ScoringService_GetCategoryPeriodComparison_Handler,
+		},
+		{
+			MethodName: "GetScoreTrend",
+			Handler:    _ScoringService_GetScoreTrend_Handler,
+		},
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _ScoringService_GetAnomalies_Handler,
+		},
+		{
+			MethodName: "GetScoreForecast",
+			Handler:    _ScoringService_GetScoreForecast_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _ScoringService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "SubmitRating",
+			Handler:    _ScoringService_SubmitRating_Handler,
+		},
+		{
+			MethodName: "BatchSubmitRatings",
+			Handler:    _ScoringService_BatchSubmitRatings_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ScoringService_ListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ScoringService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "RenameCategory",
+			Handler:    _ScoringService_RenameCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryWeight",
+			Handler:    _ScoringService_UpdateCategoryWeight_Handler,
+		},
+		{
+			MethodName: "ArchiveCategory",
+			Handler:    _ScoringService_ArchiveCategory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTicketScores",
+			Handler:       _ScoringService_StreamTicketScores_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "scoring.proto",
 }
@@ -0,0 +1,263 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/scoring"
+)
+
+// Alerter evaluates alert rules with the scorers and notifies webhooks of the
+// rules that start firing or resolve
+type Alerter struct {
+	rules      []domain.AlertRule
+	overall    *scoring.OverallScorer
+	categories *scoring.CategoryScorer
+	states     repository.AlertStateRepository
+	notifier   Notifier
+	now        func() time.Time
+}
+
+// Option configures an alerter created by NewAlerter
+type Option func(*Alerter)
+
+// WithClock evaluates rules at now instead of the system clock
+func WithClock(now func() time.Time) Option {
+	return func(a *Alerter) {
+		a.now = now
+	}
+}
+
+func NewAlerter(rules []domain.AlertRule, overall *scoring.OverallScorer, categories *scoring.CategoryScorer, states repository.AlertStateRepository, notifier Notifier, opts ...Option) *Alerter {
+	a := &Alerter{
+		rules:      rules,
+		overall:    overall,
+		categories: categories,
+		states:     states,
+		notifier:   notifier,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Run evaluates the rules right away and then every interval until ctx is done
+func (a *Alerter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Evaluations interrupted by shutdown are not failures
+		if err := a.Evaluate(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Alert evaluation failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate checks every rule once. Only changes of state are notified, and a
+// rule does not fire again within its cooldown of its last notification. A
+// change of state is kept even when some webhooks fail to receive it; the
+// notification is sent again to just those webhooks at the next evaluation.
+func (a *Alerter) Evaluate(ctx context.Context) error {
+	states, err := a.states.ListAlertStates(ctx)
+	if err != nil {
+		return err
+	}
+	byRule := make(map[string]domain.AlertState, len(states))
+	for _, state := range states {
+		byRule[state.RuleName] = state
+	}
+
+	var errs []error
+	for _, rule := range a.rules {
+		state, ok := byRule[rule.Name]
+		if !ok {
+			state = domain.AlertState{RuleName: rule.Name, Status: domain.AlertOK}
+		}
+		if err := a.evaluate(ctx, rule, state); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Alerter) evaluate(ctx context.Context, rule domain.AlertRule, state domain.AlertState) error {
+	next := state
+	var errs []error
+
+	// Webhooks that missed the last notification get it before any newer one
+	resent := len(state.PendingWebhooks) > 0
+	if resent {
+		if err := a.resend(ctx, &next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	judged, err := a.update(ctx, rule, &next)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if resent || judged {
+		if err := a.states.SaveAlertState(ctx, next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// update judges the rule, notifies a change of state and records it in state.
+// It reports whether the rule could be judged.
+func (a *Alerter) update(ctx context.Context, rule domain.AlertRule, state *domain.AlertState) (bool, error) {
+	now := a.now()
+	start := now.Add(-rule.Window)
+	result, err := a.measure(ctx, rule, start, now)
+	if err != nil {
+		return false, err
+	}
+
+	value, breached, ok := judge(rule, result)
+	if !ok {
+		return false, nil
+	}
+
+	previous := *state
+	state.Value = value
+	state.UpdatedAt = now
+	if state.Since.IsZero() {
+		state.Since = now
+	}
+
+	event := Event{
+		Rule:          rule.Name,
+		Category:      rule.Category,
+		Condition:     string(rule.Condition),
+		Threshold:     rule.Threshold,
+		Value:         value,
+		Score:         result.CurrentScore,
+		PreviousScore: result.PreviousScore,
+		RatingCount:   result.CurrentCount,
+		WindowStart:   start.UTC(),
+		WindowEnd:     now.UTC(),
+	}
+	switch {
+	case breached && previous.Status != domain.AlertFiring:
+		if previous.LastNotifiedAt != nil && now.Sub(*previous.LastNotifiedAt) < rule.Cooldown {
+			break
+		}
+		state.Status = domain.AlertFiring
+		state.Since = now
+		event.Status = EventFiring
+		event.FiredAt = now.UTC()
+	case !breached && previous.Status == domain.AlertFiring:
+		state.Status = domain.AlertOK
+		state.Since = now
+		event.Status = EventResolved
+		event.FiredAt = previous.Since.UTC()
+		resolvedAt := now.UTC()
+		event.ResolvedAt = &resolvedAt
+	}
+
+	if event.Status == "" {
+		return true, nil
+	}
+
+	// A newer event supersedes one that is still pending
+	state.LastNotifiedAt = &now
+	state.PendingEvent, state.PendingWebhooks = nil, nil
+	failed, notifyErr := a.notifier.Notify(ctx, event)
+	if len(failed) > 0 {
+		pending, err := json.Marshal(event)
+		if err != nil {
+			return true, fmt.Errorf("failed to encode %s event: %w", event.Status, err)
+		}
+		state.PendingEvent, state.PendingWebhooks = pending, failed
+	}
+	if notifyErr != nil {
+		return true, fmt.Errorf("failed to notify %s: %w", event.Status, notifyErr)
+	}
+	return true, nil
+}
+
+// resend delivers the pending event of state to the webhooks that missed it,
+// leaving pending those that fail again
+func (a *Alerter) resend(ctx context.Context, state *domain.AlertState) error {
+	var event Event
+	if err := json.Unmarshal(state.PendingEvent, &event); err != nil {
+		// It could never be delivered, so it is dropped
+		state.PendingEvent, state.PendingWebhooks = nil, nil
+		return fmt.Errorf("invalid pending event: %w", err)
+	}
+
+	failed, err := a.notifier.Resend(ctx, event, state.PendingWebhooks)
+	state.PendingWebhooks = failed
+	if len(failed) == 0 {
+		state.PendingEvent = nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resend %s: %w", event.Status, err)
+	}
+	return nil
+}
+
+// measure compares the window of the rule ending at end with the window before
+func (a *Alerter) measure(ctx context.Context, rule domain.AlertRule, start, end time.Time) (domain.PeriodComparisonResult, error) {
+	previousStart := start.Add(-rule.Window)
+	opts := domain.ComparisonOptions{ConfidenceLevel: domain.DefaultConfidenceLevel, Alpha: domain.DefaultSignificanceLevel}
+
+	if rule.Category == "" {
+		result, err := a.overall.GetPeriodComparison(ctx, start, end, previousStart, start, rule.Filter, opts)
+		if err != nil {
+			return domain.PeriodComparisonResult{}, err
+		}
+		return *result, nil
+	}
+
+	comparisons, err := a.categories.GetCategoryPeriodComparison(ctx, start, end, previousStart, start, rule.Filter, opts)
+	if err != nil {
+		return domain.PeriodComparisonResult{}, err
+	}
+	for _, c := range comparisons {
+		if c.CategoryName == rule.Category {
+			return c.PeriodComparisonResult, nil
+		}
+	}
+	// Not rated in either window
+	return domain.PeriodComparisonResult{}, nil
+}
+
+// judge returns the value the rule compares with its threshold and whether it
+// is breached. ok is false when the windows have too few ratings to judge.
+func judge(rule domain.AlertRule, result domain.PeriodComparisonResult) (value float64, breached, ok bool) {
+	if result.CurrentCount < rule.MinRatings {
+		return 0, false, false
+	}
+
+	switch rule.Condition {
+	case domain.AlertBelow:
+		return result.CurrentScore, result.CurrentScore < rule.Threshold, true
+	case domain.AlertAbove:
+		return result.CurrentScore, result.CurrentScore > rule.Threshold, true
+	case domain.AlertDrop:
+		if result.PreviousCount < rule.MinRatings {
+			return 0, false, false
+		}
+		drop := -result.AbsoluteChange
+		return drop, drop > rule.Threshold, true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,151 @@
+package alerting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// DefaultInterval is how often rules are evaluated when the config does not say
+const DefaultInterval = 5 * time.Minute
+
+// Config is the alerting setup read from a rules file
+type Config struct {
+	Interval time.Duration
+	Webhooks []string
+	Rules    []domain.AlertRule
+}
+
+type fileConfig struct {
+	Interval string     `json:"interval"`
+	Webhooks []string   `json:"webhooks"`
+	Rules    []fileRule `json:"rules"`
+}
+
+type fileRule struct {
+	Name       string     `json:"name"`
+	Category   string     `json:"category"`
+	Filter     fileFilter `json:"filter"`
+	Window     string     `json:"window"`
+	Condition  string     `json:"condition"`
+	Threshold  float64    `json:"threshold"`
+	MinRatings int        `json:"min_ratings"`
+	Cooldown   string     `json:"cooldown"`
+}
+
+type fileFilter struct {
+	AssigneeIDs []int    `json:"assignee_ids"`
+	Teams       []string `json:"teams"`
+	Channels    []string `json:"channels"`
+	Priorities  []string `json:"priorities"`
+	Tags        []string `json:"tags"`
+}
+
+// LoadConfig reads and validates a JSON rules file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read alert rules: %w", err)
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses and validates the JSON of a rules file. Durations are Go
+// durations such as "24h"; the interval defaults to DefaultInterval and
+// min_ratings to 1.
+func ParseConfig(data []byte) (Config, error) {
+	var file fileConfig
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return Config{}, fmt.Errorf("invalid alert rules: %w", err)
+	}
+
+	cfg := Config{Interval: DefaultInterval, Webhooks: file.Webhooks}
+	if file.Interval != "" {
+		interval, err := time.ParseDuration(file.Interval)
+		if err != nil || interval <= 0 {
+			return Config{}, fmt.Errorf("invalid interval: %q", file.Interval)
+		}
+		cfg.Interval = interval
+	}
+
+	if len(cfg.Webhooks) == 0 && len(file.Rules) > 0 {
+		return Config{}, fmt.Errorf("alert rules need at least one webhook")
+	}
+	for _, webhook := range cfg.Webhooks {
+		if u, err := url.Parse(webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Config{}, fmt.Errorf("invalid webhook url: %q", webhook)
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, r := range file.Rules {
+		rule, err := r.rule()
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid rule %q: %w", r.Name, err)
+		}
+		if names[rule.Name] {
+			return Config{}, fmt.Errorf("duplicate rule name: %q", rule.Name)
+		}
+		names[rule.Name] = true
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	return cfg, nil
+}
+
+func (r fileRule) rule() (domain.AlertRule, error) {
+	rule := domain.AlertRule{
+		Name:     strings.TrimSpace(r.Name),
+		Category: strings.TrimSpace(r.Category),
+		Filter: domain.TicketFilter{
+			AssigneeIDs: r.Filter.AssigneeIDs,
+			Teams:       r.Filter.Teams,
+			Channels:    r.Filter.Channels,
+			Priorities:  r.Filter.Priorities,
+			Tags:        r.Filter.Tags,
+		},
+		Condition:  domain.AlertCondition(r.Condition),
+		Threshold:  r.Threshold,
+		MinRatings: r.MinRatings,
+	}
+	if rule.Name == "" {
+		return domain.AlertRule{}, fmt.Errorf("name is required")
+	}
+
+	var err error
+	if rule.Window, err = time.ParseDuration(r.Window); err != nil || rule.Window <= 0 {
+		return domain.AlertRule{}, fmt.Errorf("invalid window: %q", r.Window)
+	}
+	if r.Cooldown != "" {
+		if rule.Cooldown, err = time.ParseDuration(r.Cooldown); err != nil || rule.Cooldown < 0 {
+			return domain.AlertRule{}, fmt.Errorf("invalid cooldown: %q", r.Cooldown)
+		}
+	}
+
+	switch rule.Condition {
+	case domain.AlertBelow, domain.AlertAbove, domain.AlertDrop:
+	default:
+		return domain.AlertRule{}, fmt.Errorf("invalid condition: %q", r.Condition)
+	}
+	// A score, or for drops a number of points
+	if rule.Threshold < 0 || rule.Threshold > 100 {
+		return domain.AlertRule{}, fmt.Errorf("threshold must be between 0 and 100")
+	}
+
+	if rule.MinRatings < 0 {
+		return domain.AlertRule{}, fmt.Errorf("min_ratings cannot be negative")
+	}
+	if rule.MinRatings == 0 {
+		rule.MinRatings = 1
+	}
+
+	return rule, nil
+}
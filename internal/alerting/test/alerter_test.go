@@ -0,0 +1,291 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/alerting"
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/migrations"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/scoring"
+
+	"github.com/stretchr/testify/require"
+)
+
+// webhook records the events posted to it and answers with status
+type webhook struct {
+	*httptest.Server
+	mu     sync.Mutex
+	events []alerting.Event
+	status int
+}
+
+func newWebhook(t *testing.T) *webhook {
+	w := &webhook{status: http.StatusOK}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if w.status == http.StatusOK {
+			var event alerting.Event
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			w.events = append(w.events, event)
+		}
+		rw.WriteHeader(w.status)
+	}))
+	t.Cleanup(w.Close)
+	return w
+}
+
+func (w *webhook) received() []alerting.Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]alerting.Event(nil), w.events...)
+}
+
+func (w *webhook) answer(status int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.status = status
+}
+
+// alertEnv is a migrated SQLite database with one category, rated through rate
+type alertEnv struct {
+	db         *sql.DB
+	categoryID int
+	now        time.Time
+}
+
+func newAlertEnv(t *testing.T) *alertEnv {
+	t.Helper()
+
+	db, dialect, err := database.Open(database.Config{
+		Type: "sqlite",
+		Path: filepath.Join(t.TempDir(), "alerts.db"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migrations.NewMigrator(db, dialect)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, dialect).
+		CreateCategory(context.Background(), "Grammar", 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	return &alertEnv{db: db, categoryID: categoryID, now: time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)}
+}
+
+func (e *alertEnv) rate(t *testing.T, createdAt time.Time, ratings ...int) {
+	t.Helper()
+	var batch []domain.Rating
+	for i, r := range ratings {
+		batch = append(batch, domain.Rating{TicketID: i + 1, RatingCategoryID: e.categoryID, Rating: r, CreatedAt: createdAt})
+	}
+	_, err := repository.NewRatingRepository(e.db, repository.SQLite).InsertRatings(context.Background(), batch)
+	require.NoError(t, err)
+}
+
+func (e *alertEnv) alerter(rules []domain.AlertRule, urls ...string) *alerting.Alerter {
+	return alerting.NewAlerter(rules,
+		scoring.NewOverallScorer(repository.NewOverallRepository(e.db, repository.SQLite)),
+		scoring.NewCategoryScorer(repository.NewCategoryRepository(e.db, repository.SQLite)),
+		repository.NewAlertStateRepository(e.db, repository.SQLite),
+		alerting.NewWebhookNotifier(urls, time.Second),
+		alerting.WithClock(func() time.Time { return e.now }))
+}
+
+func (e *alertEnv) state(t *testing.T, rule string) *domain.AlertState {
+	t.Helper()
+	states, err := repository.NewAlertStateRepository(e.db, repository.SQLite).ListAlertStates(context.Background())
+	require.NoError(t, err)
+	for _, s := range states {
+		if s.RuleName == rule {
+			return &s
+		}
+	}
+	return nil
+}
+
+func TestAlerter_FiresOnceAndResolves(t *testing.T) {
+	ctx := context.Background()
+	env := newAlertEnv(t)
+	hook := newWebhook(t)
+
+	rules := []domain.AlertRule{{Name: "overall-24h", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 1}}
+	alerter := env.alerter(rules, hook.URL)
+
+	// 40% over the last day
+	env.rate(t, env.now.Add(-time.Hour), 2, 2)
+	require.NoError(t, alerter.Evaluate(ctx))
+
+	events := hook.received()
+	require.Len(t, events, 1)
+	require.Equal(t, alerting.EventFiring, events[0].Status)
+	require.Equal(t, "overall-24h", events[0].Rule)
+	require.Equal(t, "below", events[0].Condition)
+	require.InDelta(t, 40.0, events[0].Value, 0.0001)
+	require.Equal(t, 2, events[0].RatingCount)
+	require.Equal(t, env.now, events[0].FiredAt)
+	require.Equal(t, env.now.Add(-24*time.Hour), events[0].WindowStart)
+	require.Equal(t, domain.AlertFiring, env.state(t, "overall-24h").Status)
+
+	// Still firing, also for a new alerter reading the persisted state
+	env.now = env.now.Add(5 * time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+	require.NoError(t, env.alerter(rules, hook.URL).Evaluate(ctx))
+	require.Len(t, hook.received(), 1)
+
+	// (2·2 + 8·5) / 50 = 88%
+	env.rate(t, env.now.Add(-time.Minute), 5, 5, 5, 5, 5, 5, 5, 5)
+	env.now = env.now.Add(5 * time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+
+	events = hook.received()
+	require.Len(t, events, 2)
+	require.Equal(t, alerting.EventResolved, events[1].Status)
+	require.InDelta(t, 88.0, events[1].Value, 0.0001)
+	require.Equal(t, events[0].FiredAt, events[1].FiredAt)
+	require.NotNil(t, events[1].ResolvedAt)
+	require.Equal(t, env.now, *events[1].ResolvedAt)
+
+	state := env.state(t, "overall-24h")
+	require.Equal(t, domain.AlertOK, state.Status)
+	require.Equal(t, env.now, state.Since)
+	require.Equal(t, env.now, *state.LastNotifiedAt)
+}
+
+func TestAlerter_CategoryDropWithCooldown(t *testing.T) {
+	ctx := context.Background()
+	env := newAlertEnv(t)
+	hook := newWebhook(t)
+
+	rules := []domain.AlertRule{{
+		Name:       "grammar-wow",
+		Category:   "Grammar",
+		Window:     7 * 24 * time.Hour,
+		Condition:  domain.AlertDrop,
+		Threshold:  10,
+		MinRatings: 1,
+		Cooldown:   time.Hour,
+	}}
+	alerter := env.alerter(rules, hook.URL)
+
+	// 100% the week before, 80% this week
+	env.rate(t, env.now.AddDate(0, 0, -10), 5, 5)
+	env.rate(t, env.now.AddDate(0, 0, -1), 4)
+	require.NoError(t, alerter.Evaluate(ctx))
+
+	events := hook.received()
+	require.Len(t, events, 1)
+	require.Equal(t, "Grammar", events[0].Category)
+	require.InDelta(t, 20.0, events[0].Value, 0.0001)
+	require.InDelta(t, 100.0, events[0].PreviousScore, 0.0001)
+
+	// Recovers to (4 + 9·5) / 50 = 98%
+	env.rate(t, env.now.Add(-time.Minute), 5, 5, 5, 5, 5, 5, 5, 5, 5)
+	env.now = env.now.Add(time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+	require.Len(t, hook.received(), 2)
+	resolvedAt := env.now
+
+	// Drops again right away, but the rule is cooling down
+	env.rate(t, env.now.Add(-time.Minute), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	env.now = env.now.Add(10 * time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+	require.Len(t, hook.received(), 2)
+	require.Equal(t, domain.AlertOK, env.state(t, "grammar-wow").Status)
+
+	env.now = resolvedAt.Add(time.Hour)
+	require.NoError(t, alerter.Evaluate(ctx))
+	events = hook.received()
+	require.Len(t, events, 3)
+	require.Equal(t, alerting.EventFiring, events[2].Status)
+}
+
+func TestAlerter_RetriesFailedNotification(t *testing.T) {
+	ctx := context.Background()
+	env := newAlertEnv(t)
+	hook := newWebhook(t)
+	hook.answer(http.StatusInternalServerError)
+
+	rules := []domain.AlertRule{{Name: "overall-24h", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 1}}
+	alerter := env.alerter(rules, hook.URL)
+
+	// The rule fires even though the webhook missed it
+	env.rate(t, env.now.Add(-time.Hour), 0)
+	require.Error(t, alerter.Evaluate(ctx))
+	state := env.state(t, "overall-24h")
+	require.Equal(t, domain.AlertFiring, state.Status)
+	require.Equal(t, []string{hook.URL}, state.PendingWebhooks)
+
+	hook.answer(http.StatusOK)
+	env.now = env.now.Add(5 * time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+	events := hook.received()
+	require.Len(t, events, 1)
+	require.Equal(t, alerting.EventFiring, events[0].Status)
+	require.Equal(t, env.now.Add(-5*time.Minute), events[0].FiredAt)
+
+	state = env.state(t, "overall-24h")
+	require.Equal(t, domain.AlertFiring, state.Status)
+	require.Empty(t, state.PendingWebhooks)
+	require.Nil(t, state.PendingEvent)
+}
+
+func TestAlerter_RetriesOnlyFailedWebhooks(t *testing.T) {
+	ctx := context.Background()
+	env := newAlertEnv(t)
+	good := newWebhook(t)
+	failing := newWebhook(t)
+	failing.answer(http.StatusServiceUnavailable)
+
+	rules := []domain.AlertRule{{Name: "overall-24h", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 1}}
+	alerter := env.alerter(rules, good.URL, failing.URL)
+
+	env.rate(t, env.now.Add(-time.Hour), 0)
+	require.Error(t, alerter.Evaluate(ctx))
+	require.Equal(t, []string{failing.URL}, env.state(t, "overall-24h").PendingWebhooks)
+
+	env.now = env.now.Add(5 * time.Minute)
+	require.Error(t, alerter.Evaluate(ctx))
+	require.Len(t, good.received(), 1)
+	require.Empty(t, failing.received())
+
+	failing.answer(http.StatusOK)
+	env.now = env.now.Add(5 * time.Minute)
+	require.NoError(t, alerter.Evaluate(ctx))
+	require.Len(t, good.received(), 1)
+	require.Equal(t, good.received(), failing.received())
+	require.Empty(t, env.state(t, "overall-24h").PendingWebhooks)
+}
+
+func TestAlerter_SkipsWindowsWithTooFewRatings(t *testing.T) {
+	ctx := context.Background()
+	env := newAlertEnv(t)
+	hook := newWebhook(t)
+
+	rules := []domain.AlertRule{
+		{Name: "overall-24h", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 5},
+		{Name: "unrated", Category: "Spelling", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 1},
+	}
+
+	env.rate(t, env.now.Add(-time.Hour), 0, 0)
+	require.NoError(t, env.alerter(rules, hook.URL).Evaluate(ctx))
+	require.Empty(t, hook.received())
+	require.Nil(t, env.state(t, "overall-24h"))
+	require.Nil(t, env.state(t, "unrated"))
+}
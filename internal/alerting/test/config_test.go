@@ -0,0 +1,67 @@
+package alerting_test
+
+import (
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/alerting"
+	"ticket-score-engine/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := alerting.ParseConfig([]byte(`{
+		"interval": "1m",
+		"webhooks": ["https://hooks.example.com/quality"],
+		"rules": [
+			{"name": "overall-24h", "window": "24h", "condition": "below", "threshold": 80},
+			{"name": "grammar-wow", "category": "Grammar", "filter": {"teams": ["billing"]},
+			 "window": "168h", "condition": "drop", "threshold": 10, "min_ratings": 20, "cooldown": "6h"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	require.Equal(t, time.Minute, cfg.Interval)
+	require.Equal(t, []string{"https://hooks.example.com/quality"}, cfg.Webhooks)
+	require.Equal(t, []domain.AlertRule{
+		{Name: "overall-24h", Window: 24 * time.Hour, Condition: domain.AlertBelow, Threshold: 80, MinRatings: 1},
+		{
+			Name:       "grammar-wow",
+			Category:   "Grammar",
+			Filter:     domain.TicketFilter{Teams: []string{"billing"}},
+			Window:     168 * time.Hour,
+			Condition:  domain.AlertDrop,
+			Threshold:  10,
+			MinRatings: 20,
+			Cooldown:   6 * time.Hour,
+		},
+	}, cfg.Rules)
+}
+
+func TestParseConfig_Defaults(t *testing.T) {
+	cfg, err := alerting.ParseConfig([]byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, alerting.DefaultInterval, cfg.Interval)
+	require.Empty(t, cfg.Rules)
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	for name, config := range map[string]string{
+		"unknown field":      `{"rules": [], "webhook": "https://hooks.example.com"}`,
+		"no webhook":         `{"rules": [{"name": "a", "window": "1h", "condition": "below", "threshold": 80}]}`,
+		"relative webhook":   `{"webhooks": ["/hooks"]}`,
+		"missing name":       `{"webhooks": ["https://h.example.com"], "rules": [{"window": "1h", "condition": "below", "threshold": 80}]}`,
+		"unknown condition":  `{"webhooks": ["https://h.example.com"], "rules": [{"name": "a", "window": "1h", "condition": "under", "threshold": 80}]}`,
+		"missing window":     `{"webhooks": ["https://h.example.com"], "rules": [{"name": "a", "condition": "below", "threshold": 80}]}`,
+		"threshold over 100": `{"webhooks": ["https://h.example.com"], "rules": [{"name": "a", "window": "1h", "condition": "below", "threshold": 120}]}`,
+		"duplicate names": `{"webhooks": ["https://h.example.com"], "rules": [
+			{"name": "a", "window": "1h", "condition": "below", "threshold": 80},
+			{"name": "a", "window": "2h", "condition": "drop", "threshold": 5}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := alerting.ParseConfig([]byte(config))
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	EventFiring   = "firing"
+	EventResolved = "resolved"
+)
+
+// Event is the JSON payload posted to webhooks when a rule fires or resolves
+type Event struct {
+	Rule          string     `json:"rule"`
+	Status        string     `json:"status"` // EventFiring or EventResolved
+	Category      string     `json:"category,omitempty"`
+	Condition     string     `json:"condition"`
+	Threshold     float64    `json:"threshold"`
+	Value         float64    `json:"value"` // Score, or for drops the points lost
+	Score         float64    `json:"score"`
+	PreviousScore float64    `json:"previous_score"`
+	RatingCount   int        `json:"rating_count"`
+	WindowStart   time.Time  `json:"window_start"`
+	WindowEnd     time.Time  `json:"window_end"`
+	FiredAt       time.Time  `json:"fired_at"`
+	ResolvedAt    *time.Time `json:"resolved_at,omitempty"`
+}
+
+// Notifier delivers the events of rules
+type Notifier interface {
+	// Notify delivers event to every webhook and returns those it failed for
+	Notify(ctx context.Context, event Event) (failed []string, err error)
+	// Resend delivers event again to the webhooks that failed to receive it
+	// and returns those it failed for once more
+	Resend(ctx context.Context, event Event, webhooks []string) (failed []string, err error)
+}
+
+// WebhookNotifier posts events as JSON to a fixed set of URLs
+type WebhookNotifier struct {
+	client *http.Client
+	urls   []string
+}
+
+func NewWebhookNotifier(urls []string, timeout time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{client: &http.Client{Timeout: timeout}, urls: urls}
+}
+
+// Notify posts the event to every URL. The URLs that do not answer with a
+// 2xx status are returned along with their errors.
+func (n *WebhookNotifier) Notify(ctx context.Context, event Event) ([]string, error) {
+	return n.send(ctx, event, n.urls)
+}
+
+// Resend posts the event to those of webhooks that are still configured
+func (n *WebhookNotifier) Resend(ctx context.Context, event Event, webhooks []string) ([]string, error) {
+	var urls []string
+	for _, url := range webhooks {
+		if slices.Contains(n.urls, url) {
+			urls = append(urls, url)
+		}
+	}
+	return n.send(ctx, event, urls)
+}
+
+func (n *WebhookNotifier) send(ctx context.Context, event Event, urls []string) ([]string, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return urls, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	var failed []string
+	var errs []error
+	for _, url := range urls {
+		if err := n.post(ctx, url, body); err != nil {
+			failed = append(failed, url)
+			errs = append(errs, err)
+		}
+	}
+	return failed, errors.Join(errs...)
+}
+
+func (n *WebhookNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request for %s: %w", url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s answered %s", url, resp.Status)
+	}
+	return nil
+}
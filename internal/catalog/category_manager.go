@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+type CategoryManager struct {
+	repo repository.RatingCategoryRepository
+	now  func() time.Time
+}
+
+func NewCategoryManager(repo repository.RatingCategoryRepository) *CategoryManager {
+	return &CategoryManager{repo: repo, now: time.Now}
+}
+
+func (m *CategoryManager) ListCategories(ctx context.Context, includeArchived, includeHistory bool) ([]domain.RatingCategory, error) {
+	categories, err := m.repo.ListCategories(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	if !includeHistory {
+		return categories, nil
+	}
+
+	history, err := m.repo.ListWeightHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[int][]domain.CategoryWeight)
+	for _, cw := range history {
+		byCategory[cw.CategoryID] = append(byCategory[cw.CategoryID], cw)
+	}
+	for i := range categories {
+		categories[i].WeightHistory = byCategory[categories[i].ID]
+	}
+
+	return categories, nil
+}
+
+// CreateCategory creates a category whose weight applies from effectiveFrom,
+// or from now when effectiveFrom is zero
+func (m *CategoryManager) CreateCategory(ctx context.Context, name string, weight float64, effectiveFrom time.Time) (*domain.RatingCategory, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrInvalidCategoryName
+	}
+	if weight < 0 {
+		return nil, domain.ErrInvalidWeight
+	}
+	if effectiveFrom.IsZero() {
+		effectiveFrom = m.now()
+	}
+
+	id, err := m.repo.CreateCategory(ctx, name, weight, effectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.repo.GetCategory(ctx, id)
+}
+
+func (m *CategoryManager) RenameCategory(ctx context.Context, id int, name string) (*domain.RatingCategory, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrInvalidCategoryName
+	}
+	if _, err := m.activeCategory(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := m.repo.RenameCategory(ctx, id, name); err != nil {
+		return nil, err
+	}
+
+	return m.repo.GetCategory(ctx, id)
+}
+
+// UpdateCategoryWeight versions the category weight. Ratings made before
+// effectiveFrom (now when zero) keep being scored with the previous weight.
+func (m *CategoryManager) UpdateCategoryWeight(ctx context.Context, id int, weight float64, effectiveFrom time.Time) (*domain.RatingCategory, error) {
+	if weight < 0 {
+		return nil, domain.ErrInvalidWeight
+	}
+	if _, err := m.activeCategory(ctx, id); err != nil {
+		return nil, err
+	}
+	if effectiveFrom.IsZero() {
+		effectiveFrom = m.now()
+	}
+
+	if err := m.repo.UpdateCategoryWeight(ctx, id, weight, effectiveFrom); err != nil {
+		return nil, err
+	}
+
+	return m.repo.GetCategory(ctx, id)
+}
+
+// ArchiveCategory stops the category from accepting new ratings. Existing ratings
+// keep counting towards scores of the periods they were made in.
+func (m *CategoryManager) ArchiveCategory(ctx context.Context, id int) (*domain.RatingCategory, error) {
+	if _, err := m.activeCategory(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := m.repo.ArchiveCategory(ctx, id, m.now()); err != nil {
+		return nil, err
+	}
+
+	return m.repo.GetCategory(ctx, id)
+}
+
+func (m *CategoryManager) activeCategory(ctx context.Context, id int) (*domain.RatingCategory, error) {
+	category, err := m.repo.GetCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category.ArchivedAt != nil {
+		return nil, domain.ErrCategoryArchived
+	}
+	return category, nil
+}
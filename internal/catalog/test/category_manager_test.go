@@ -0,0 +1,116 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/catalog"
+	"ticket-score-engine/internal/domain"
+)
+
+type mockRatingCategoryRepo struct {
+	mock.Mock
+}
+
+func (m *mockRatingCategoryRepo) ListCategories(ctx context.Context, includeArchived bool) ([]domain.RatingCategory, error) {
+	args := m.Called(ctx, includeArchived)
+	return args.Get(0).([]domain.RatingCategory), args.Error(1)
+}
+
+func (m *mockRatingCategoryRepo) GetCategory(ctx context.Context, id int) (*domain.RatingCategory, error) {
+	args := m.Called(ctx, id)
+	category, _ := args.Get(0).(*domain.RatingCategory)
+	return category, args.Error(1)
+}
+
+func (m *mockRatingCategoryRepo) ListWeightHistory(ctx context.Context) ([]domain.CategoryWeight, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.CategoryWeight), args.Error(1)
+}
+
+func (m *mockRatingCategoryRepo) CreateCategory(ctx context.Context, name string, weight float64, effectiveFrom time.Time) (int, error) {
+	args := m.Called(ctx, name, weight, effectiveFrom)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockRatingCategoryRepo) RenameCategory(ctx context.Context, id int, name string) error {
+	return m.Called(ctx, id, name).Error(0)
+}
+
+func (m *mockRatingCategoryRepo) UpdateCategoryWeight(ctx context.Context, id int, weight float64, effectiveFrom time.Time) error {
+	return m.Called(ctx, id, weight, effectiveFrom).Error(0)
+}
+
+func (m *mockRatingCategoryRepo) ArchiveCategory(ctx context.Context, id int, archivedAt time.Time) error {
+	return m.Called(ctx, id, archivedAt).Error(0)
+}
+
+func TestListCategories_WithHistory(t *testing.T) {
+	mockRepo := new(mockRatingCategoryRepo)
+	manager := catalog.NewCategoryManager(mockRepo)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("ListCategories", mock.Anything, false).Return([]domain.RatingCategory{
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 2, Name: "GDPR", Weight: 0.5},
+	}, nil)
+	mockRepo.On("ListWeightHistory", mock.Anything).Return([]domain.CategoryWeight{
+		{CategoryID: 2, Weight: 0.8, EffectiveFrom: from, EffectiveTo: &from},
+		{CategoryID: 2, Weight: 0.5, EffectiveFrom: from},
+	}, nil)
+
+	categories, err := manager.ListCategories(context.Background(), false, true)
+
+	assert.NoError(t, err)
+	assert.Empty(t, categories[0].WeightHistory)
+	assert.Len(t, categories[1].WeightHistory, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateCategory_Validation(t *testing.T) {
+	mockRepo := new(mockRatingCategoryRepo)
+	manager := catalog.NewCategoryManager(mockRepo)
+
+	_, err := manager.CreateCategory(context.Background(), "  ", 1, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrInvalidCategoryName)
+
+	_, err = manager.CreateCategory(context.Background(), "Tone", -1, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrInvalidWeight)
+
+	mockRepo.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateCategoryWeight_Success(t *testing.T) {
+	mockRepo := new(mockRatingCategoryRepo)
+	manager := catalog.NewCategoryManager(mockRepo)
+
+	effectiveFrom := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	updated := &domain.RatingCategory{ID: 1, Name: "Spelling", Weight: 0.5}
+
+	mockRepo.On("GetCategory", mock.Anything, 1).Return(&domain.RatingCategory{ID: 1, Name: "Spelling", Weight: 1}, nil).Once()
+	mockRepo.On("UpdateCategoryWeight", mock.Anything, 1, 0.5, effectiveFrom).Return(nil)
+	mockRepo.On("GetCategory", mock.Anything, 1).Return(updated, nil).Once()
+
+	category, err := manager.UpdateCategoryWeight(context.Background(), 1, 0.5, effectiveFrom)
+
+	assert.NoError(t, err)
+	assert.Equal(t, updated, category)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestArchiveCategory_AlreadyArchived(t *testing.T) {
+	mockRepo := new(mockRatingCategoryRepo)
+	manager := catalog.NewCategoryManager(mockRepo)
+
+	archivedAt := time.Now()
+	mockRepo.On("GetCategory", mock.Anything, 3).Return(&domain.RatingCategory{ID: 3, ArchivedAt: &archivedAt}, nil)
+
+	_, err := manager.ArchiveCategory(context.Background(), 3)
+
+	assert.ErrorIs(t, err, domain.ErrCategoryArchived)
+	mockRepo.AssertNotCalled(t, "ArchiveCategory", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,313 @@
+package config
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/repository"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the runtime configuration of the server
+type Config struct {
+	ListenAddr string          `yaml:"listen_addr"`
+	Database   database.Config `yaml:"database"`
+	Timeouts   Timeouts        `yaml:"timeouts"`
+	TLS        TLS             `yaml:"tls"`
+	Health     Health          `yaml:"health"`
+	Features   Features        `yaml:"features"`
+}
+
+// Timeouts bound how long the server waits, where 0 means no limit
+type Timeouts struct {
+	// Request is the deadline of unary calls; streams are not limited
+	Request time.Duration `yaml:"request"`
+	// Webhook is how long an alert webhook may take to answer
+	Webhook time.Duration `yaml:"webhook"`
+	// Shutdown is how long running calls may take to finish on SIGTERM
+	// before they are cut off
+	Shutdown time.Duration `yaml:"shutdown"`
+	// ShutdownDelay is how long new calls are still accepted on SIGTERM after
+	// readiness turned NOT_SERVING, 0 for none
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+}
+
+// TLS enables TLS when a certificate is configured, and requires client
+// certificates signed by ClientCAFile when that is set too
+type TLS struct {
+	CertFile     string `yaml:"cert_file"`
+	KeyFile      string `yaml:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+// Health configures the database pings that readiness follows
+type Health struct {
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// Features switches optional parts of the server on or off
+type Features struct {
+	// AutoMigrate applies pending schema migrations when the server starts
+	AutoMigrate bool `yaml:"auto_migrate"`
+	// AlertRulesFile enables alerting with the rules in that file
+	AlertRulesFile string `yaml:"alert_rules_file"`
+	// Reflection lets clients such as grpcurl list and call the services
+	// without their proto files
+	Reflection bool `yaml:"reflection"`
+}
+
+// Default returns the configuration used for everything that is not set:
+// plaintext gRPC on :50051 and the local SQLite database
+func Default() Config {
+	return Config{
+		ListenAddr: ":50051",
+		Database: database.Config{
+			Type:    "sqlite",
+			Path:    "./database.db",
+			Host:    "localhost",
+			SSLMode: "disable",
+		},
+		Timeouts: Timeouts{
+			Request:  30 * time.Second,
+			Webhook:  10 * time.Second,
+			Shutdown: 20 * time.Second,
+		},
+		Health: Health{
+			Interval: 10 * time.Second,
+			Timeout:  2 * time.Second,
+		},
+		Features: Features{AutoMigrate: true},
+	}
+}
+
+// envNames are the environment variables of the flags, in the order they are
+// applied
+var envNames = []struct{ flag, env string }{
+	{"listen", "LISTEN_ADDR"},
+	{"db-type", "DB_TYPE"},
+	{"db-path", "DB_PATH"},
+	{"db-host", "DB_HOST"},
+	{"db-port", "DB_PORT"},
+	{"db-name", "DB_NAME"},
+	{"db-user", "DB_USER"},
+	{"db-sslmode", "DB_SSLMODE"},
+	{"db-dsn", "DB_DSN"},
+	{"db-max-open-conns", "DB_MAX_OPEN_CONNS"},
+	{"db-max-idle-conns", "DB_MAX_IDLE_CONNS"},
+	{"db-conn-max-lifetime", "DB_CONN_MAX_LIFETIME"},
+	{"db-conn-max-idle-time", "DB_CONN_MAX_IDLE_TIME"},
+	{"request-timeout", "REQUEST_TIMEOUT"},
+	{"webhook-timeout", "WEBHOOK_TIMEOUT"},
+	{"shutdown-timeout", "SHUTDOWN_TIMEOUT"},
+	{"shutdown-delay", "SHUTDOWN_DELAY"},
+	{"tls-cert", "TLS_CERT_FILE"},
+	{"tls-key", "TLS_KEY_FILE"},
+	{"tls-client-ca", "TLS_CLIENT_CA_FILE"},
+	{"health-interval", "HEALTH_INTERVAL"},
+	{"health-timeout", "HEALTH_TIMEOUT"},
+	{"auto-migrate", "DB_AUTO_MIGRATE"},
+	{"alert-rules", "ALERT_RULES_FILE"},
+	{"reflection", "GRPC_REFLECTION"},
+}
+
+// Load builds the configuration of the command name from its arguments. Flags
+// take precedence over environment variables, which take precedence over the
+// YAML file given by -config or CONFIG_FILE. It returns the arguments left
+// after the flags; -h returns flag.ErrHelp.
+func Load(name string, args []string) (Config, []string, error) {
+	// A first pass only finds the config file, since the flags override it
+	path := os.Getenv("CONFIG_FILE")
+	scratch := Default()
+	if err := scratch.flagSet(name, &path).Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	cfg := Default()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return Config{}, nil, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return Config{}, nil, err
+	}
+
+	flags := cfg.flagSet(name, &path)
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return Config{}, nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, flags.Args(), nil
+}
+
+// flagSet defines the flags of every setting, bound to c. The database
+// password has no flag, as command lines are visible to other users.
+func (c *Config) flagSet(name string, path *string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(path, "config", *path, "YAML config file")
+	fs.StringVar(&c.ListenAddr, "listen", c.ListenAddr, "address the gRPC server listens on")
+
+	db := &c.Database
+	fs.StringVar(&db.Type, "db-type", db.Type, "database: sqlite, postgres or mysql")
+	fs.StringVar(&db.Path, "db-path", db.Path, "SQLite database file")
+	fs.StringVar(&db.Host, "db-host", db.Host, "PostgreSQL/MySQL host")
+	fs.StringVar(&db.Port, "db-port", db.Port, "PostgreSQL/MySQL port")
+	fs.StringVar(&db.Name, "db-name", db.Name, "PostgreSQL/MySQL database name")
+	fs.StringVar(&db.User, "db-user", db.User, "PostgreSQL/MySQL user")
+	fs.StringVar(&db.SSLMode, "db-sslmode", db.SSLMode, "PostgreSQL sslmode")
+	fs.StringVar(&db.DSN, "db-dsn", db.DSN, "data source name used in place of the other database settings")
+	fs.IntVar(&db.MaxOpenConns, "db-max-open-conns", db.MaxOpenConns, "maximum open connections, 0 for no limit")
+	fs.IntVar(&db.MaxIdleConns, "db-max-idle-conns", db.MaxIdleConns, "maximum idle connections, 0 for the default of 2")
+	fs.DurationVar(&db.ConnMaxLifetime, "db-conn-max-lifetime", db.ConnMaxLifetime, "how long a connection is reused, 0 for no limit")
+	fs.DurationVar(&db.ConnMaxIdleTime, "db-conn-max-idle-time", db.ConnMaxIdleTime, "how long a connection may be idle, 0 for no limit")
+
+	fs.DurationVar(&c.Timeouts.Request, "request-timeout", c.Timeouts.Request, "deadline of unary calls, 0 for none")
+	fs.DurationVar(&c.Timeouts.Webhook, "webhook-timeout", c.Timeouts.Webhook, "timeout of alert webhooks")
+	fs.DurationVar(&c.Timeouts.Shutdown, "shutdown-timeout", c.Timeouts.Shutdown, "how long running calls may finish on shutdown, 0 for no limit")
+	fs.DurationVar(&c.Timeouts.ShutdownDelay, "shutdown-delay", c.Timeouts.ShutdownDelay, "how long new calls are still accepted on shutdown once not ready")
+
+	fs.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "TLS certificate file, enables TLS")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "TLS private key file")
+	fs.StringVar(&c.TLS.ClientCAFile, "tls-client-ca", c.TLS.ClientCAFile, "CA file client certificates must be signed by")
+
+	fs.DurationVar(&c.Health.Interval, "health-interval", c.Health.Interval, "how often the database is pinged for readiness")
+	fs.DurationVar(&c.Health.Timeout, "health-timeout", c.Health.Timeout, "how long a readiness ping may take")
+
+	fs.BoolVar(&c.Features.AutoMigrate, "auto-migrate", c.Features.AutoMigrate, "apply pending schema migrations on startup")
+	fs.StringVar(&c.Features.AlertRulesFile, "alert-rules", c.Features.AlertRulesFile, "JSON alert rules file, enables alerting")
+	fs.BoolVar(&c.Features.Reflection, "reflection", c.Features.Reflection, "enable gRPC server reflection")
+	return fs
+}
+
+// loadFile reads a YAML config file over c, rejecting unknown keys
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv applies the environment variables that are set, parsing them like
+// their flags
+func (c *Config) loadEnv() error {
+	fs := c.flagSet("env", new(string))
+	for _, e := range envNames {
+		value, ok := os.LookupEnv(e.env)
+		if !ok || value == "" {
+			continue
+		}
+		if err := fs.Set(e.flag, value); err != nil {
+			return fmt.Errorf("invalid %s: %w", e.env, err)
+		}
+	}
+	if password := os.Getenv("DB_PASSWORD"); password != "" {
+		c.Database.Password = password
+	}
+	return nil
+}
+
+// Validate reports every setting that cannot work
+func (c Config) Validate() error {
+	var errs []error
+	if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("listen address: %w", err))
+	}
+
+	db := c.Database
+	dialect, err := repository.DialectByName(db.Type)
+	switch {
+	case err != nil:
+		errs = append(errs, err)
+	case db.DSN != "":
+	case dialect == repository.SQLite && db.Path == "":
+		errs = append(errs, errors.New("sqlite needs a database path"))
+	case dialect != repository.SQLite && db.Name == "":
+		errs = append(errs, fmt.Errorf("%s needs a database name", dialect.Name()))
+	}
+	if db.MaxOpenConns < 0 || db.MaxIdleConns < 0 || db.ConnMaxLifetime < 0 || db.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("connection pool settings must not be negative"))
+	}
+	if db.MaxOpenConns > 0 && db.MaxIdleConns > db.MaxOpenConns {
+		errs = append(errs, errors.New("max idle connections exceed max open connections"))
+	}
+
+	if c.Timeouts.Request < 0 || c.Timeouts.Webhook < 0 || c.Timeouts.Shutdown < 0 || c.Timeouts.ShutdownDelay < 0 {
+		errs = append(errs, errors.New("timeouts must not be negative"))
+	}
+
+	if c.Health.Interval <= 0 || c.Health.Timeout <= 0 {
+		errs = append(errs, errors.New("health check interval and timeout must be positive"))
+	}
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("TLS needs both a certificate and a key file"))
+	}
+	if c.TLS.ClientCAFile != "" && c.TLS.CertFile == "" {
+		errs = append(errs, errors.New("client certificates need TLS"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ServerTLS loads the TLS configuration of the gRPC server, nil without TLS
+func (t TLS) ServerTLS() (*tls.Config, error) {
+	if t.CertFile == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+
+	if t.ClientCAFile != "" {
+		pem, err := os.ReadFile(t.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in client CA file %s", t.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// Redacted returns the configuration as YAML with the database password and
+// the password in the DSN masked, for logging
+func (c Config) Redacted() string {
+	if c.Database.Password != "" {
+		c.Database.Password = "xxxxx"
+	}
+	c.Database.DSN = database.RedactDSN(c.Database.DSN)
+
+	out, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("failed to print configuration: %v", err)
+	}
+	return string(out)
+}
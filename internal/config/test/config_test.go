@@ -0,0 +1,143 @@
+package test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, args, err := config.Load("test", nil)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+	assert.Equal(t, config.Default(), cfg)
+	assert.Equal(t, ":50051", cfg.ListenAddr)
+	assert.Equal(t, "./database.db", cfg.Database.Path)
+	assert.True(t, cfg.Features.AutoMigrate)
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	path := writeFile(t, `
+listen_addr: ":6000"
+database:
+  type: postgres
+  host: db.internal
+  name: tickets
+  max_open_conns: 20
+timeouts:
+  request: 5s
+features:
+  auto_migrate: false
+`)
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("DB_HOST", "ticket-postgres")
+	t.Setenv("DB_PASSWORD", "secret")
+	t.Setenv("DB_MAX_IDLE_CONNS", "10")
+	t.Setenv("REQUEST_TIMEOUT", "15s")
+	t.Setenv("SHUTDOWN_DELAY", "5s")
+	t.Setenv("GRPC_REFLECTION", "true")
+
+	cfg, args, err := config.Load("test", []string{"-request-timeout", "1m", "-listen", "127.0.0.1:7000", "status"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"status"}, args)
+
+	assert.Equal(t, "127.0.0.1:7000", cfg.ListenAddr)
+	assert.Equal(t, "postgres", cfg.Database.Type)
+	assert.Equal(t, "ticket-postgres", cfg.Database.Host)
+	assert.Equal(t, "tickets", cfg.Database.Name)
+	assert.Equal(t, "secret", cfg.Database.Password)
+	assert.Equal(t, 20, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 10, cfg.Database.MaxIdleConns)
+	assert.Equal(t, time.Minute, cfg.Timeouts.Request)
+	assert.Equal(t, 10*time.Second, cfg.Timeouts.Webhook)
+	assert.Equal(t, 5*time.Second, cfg.Timeouts.ShutdownDelay)
+	assert.False(t, cfg.Features.AutoMigrate)
+	assert.True(t, cfg.Features.Reflection)
+}
+
+func TestLoad_ConfigFlag(t *testing.T) {
+	path := writeFile(t, "listen_addr: \":6000\"\n")
+	cfg, _, err := config.Load("test", []string{"-config", path})
+	require.NoError(t, err)
+	assert.Equal(t, ":6000", cfg.ListenAddr)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, _, err := config.Load("test", []string{"-config", writeFile(t, "listen: \":6000\"\n")})
+	assert.ErrorContains(t, err, "field listen not found")
+
+	_, _, err = config.Load("test", []string{"-config", filepath.Join(t.TempDir(), "missing.yml")})
+	assert.Error(t, err)
+
+	t.Run("invalid environment variable", func(t *testing.T) {
+		t.Setenv("DB_AUTO_MIGRATE", "sometimes")
+		_, _, err := config.Load("test", nil)
+		assert.ErrorContains(t, err, "DB_AUTO_MIGRATE")
+	})
+
+	_, _, err = config.Load("test", []string{"-no-such-flag"})
+	assert.Error(t, err)
+
+	_, _, err = config.Load("test", []string{"-h"})
+	assert.ErrorIs(t, err, flag.ErrHelp)
+}
+
+func TestValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		change func(*config.Config)
+		err    string
+	}{
+		"listen address without port": {func(c *config.Config) { c.ListenAddr = "localhost" }, "listen address"},
+		"unknown database":            {func(c *config.Config) { c.Database.Type = "oracle" }, "unsupported database type"},
+		"sqlite without path":         {func(c *config.Config) { c.Database.Path = "" }, "sqlite needs a database path"},
+		"mysql without name":          {func(c *config.Config) { c.Database.Type = "mysql" }, "mysql needs a database name"},
+		"negative pool size":          {func(c *config.Config) { c.Database.MaxOpenConns = -1 }, "must not be negative"},
+		"more idle than open": {func(c *config.Config) {
+			c.Database.MaxOpenConns, c.Database.MaxIdleConns = 2, 5
+		}, "max idle connections"},
+		"health checks without interval": {func(c *config.Config) { c.Health.Interval = 0 }, "health check interval"},
+		"negative timeout":               {func(c *config.Config) { c.Timeouts.Request = -time.Second }, "timeouts"},
+		"certificate without key":        {func(c *config.Config) { c.TLS.CertFile = "server.pem" }, "both a certificate and a key"},
+		"client CA without TLS":          {func(c *config.Config) { c.TLS.ClientCAFile = "ca.pem" }, "client certificates need TLS"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.Default()
+			tc.change(&cfg)
+			assert.ErrorContains(t, cfg.Validate(), tc.err)
+		})
+	}
+
+	cfg := config.Default()
+	cfg.Database.Type, cfg.Database.Path, cfg.Database.DSN = "mysql", "", "user:pw@tcp(db:3306)/tickets"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := config.Default()
+	cfg.Database.Password = "hunter2"
+	cfg.Database.DSN = "postgres://scorer:hunter2@db:5432/tickets"
+
+	out := cfg.Redacted()
+	assert.NotContains(t, out, "hunter2")
+	assert.Contains(t, out, "password: xxxxx")
+	assert.Contains(t, out, "postgres://scorer:xxxxx@db:5432/tickets")
+	assert.Contains(t, out, "request: 30s")
+
+	cfg.Database.DSN = "scorer:hunter2@tcp(db:3306)/tickets"
+	assert.NotContains(t, cfg.Redacted(), "hunter2")
+	assert.Equal(t, "hunter2", cfg.Database.Password)
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"ticket-score-engine/internal/repository"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// Config describes which database to connect to. It mirrors the DB_* environment
+// variables used by the deployment manifests.
+type Config struct {
+	Type     string `yaml:"type"` // sqlite, postgres or mysql
+	Path     string `yaml:"path"` // SQLite database file
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Name     string `yaml:"name"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	SSLMode  string `yaml:"sslmode"` // PostgreSQL only
+
+	// DSN is passed to the driver in place of the settings above. PostgreSQL
+	// sessions are still set to UTC and MySQL times still parsed in UTC.
+	DSN string `yaml:"dsn"`
+
+	// Connection pool limits, where 0 keeps the database/sql default
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+}
+
+// Open connects to the configured database and returns the SQL dialect the
+// repositories should use with it
+func Open(cfg Config) (*sql.DB, repository.Dialect, error) {
+	dialect, err := repository.DialectByName(cfg.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	driver, dsn := DSN(cfg, dialect)
+	if cfg.DSN != "" {
+		dsn = cfg.DSN
+	}
+
+	var db *sql.DB
+	switch dialect {
+	case repository.Postgres:
+		connConfig, err := PostgresConnConfig(dsn)
+		if err != nil {
+			return nil, nil, err
+		}
+		db = stdlib.OpenDB(*connConfig)
+	case repository.MySQL:
+		if dsn, err = mysqlUTC(dsn); err != nil {
+			return nil, nil, err
+		}
+		fallthrough
+	default:
+		if db, err = sql.Open(driver, dsn); err != nil {
+			return nil, nil, fmt.Errorf("failed to open %s database: %w", dialect.Name(), err)
+		}
+	}
+
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	return db, dialect, nil
+}
+
+// DSN returns the driver name and data source name for the configuration
+func DSN(cfg Config, dialect repository.Dialect) (string, string) {
+	switch dialect {
+	case repository.Postgres:
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(cfg.User, cfg.Password),
+			Host:     net.JoinHostPort(cfg.Host, defaultString(cfg.Port, "5432")),
+			Path:     "/" + cfg.Name,
+			RawQuery: url.Values{"sslmode": {cfg.SSLMode}}.Encode(),
+		}
+		return "pgx", u.String()
+	case repository.MySQL:
+		mc := mysql.NewConfig()
+		mc.User = cfg.User
+		mc.Passwd = cfg.Password
+		mc.Net = "tcp"
+		mc.Addr = net.JoinHostPort(cfg.Host, defaultString(cfg.Port, "3306"))
+		mc.DBName = cfg.Name
+		mc.ParseTime = true
+		mc.Loc = time.UTC
+		return "mysql", mc.FormatDSN()
+	default:
+		// Store timestamps in a format SQLite's date functions understand
+		return "sqlite", "file:" + cfg.Path + "?_time_format=sqlite"
+	}
+}
+
+// PostgresConnConfig parses a PostgreSQL DSN and sets the session time zone of
+// every connection to UTC, which DATE_TRUNC and TO_CHAR buckets rely on,
+// whatever the DSN or the server default says
+func PostgresConnConfig(dsn string) (*pgx.ConnConfig, error) {
+	connConfig, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid postgres DSN: %w", err)
+	}
+	connConfig.RuntimeParams["timezone"] = "UTC"
+	return connConfig, nil
+}
+
+// mysqlUTC makes the driver parse DATETIME columns into UTC times
+func mysqlUTC(dsn string) (string, error) {
+	mc, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", fmt.Errorf("invalid mysql DSN: %w", err)
+	}
+	mc.ParseTime = true
+	mc.Loc = time.UTC
+	return mc.FormatDSN(), nil
+}
+
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// RedactDSN masks the password of a URL or MySQL style data source name, the
+// same way url.URL.Redacted does
+func RedactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+	if mc, err := mysql.ParseDSN(dsn); err == nil && mc.Passwd != "" {
+		mc.Passwd = "xxxxx"
+		return mc.FormatDSN()
+	}
+	return dsn
+}
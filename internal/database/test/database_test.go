@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresConnConfig_SessionInUTC(t *testing.T) {
+	for name, dsn := range map[string]string{
+		"generated":              mustDSN(t, database.Config{Host: "db", Name: "tickets", User: "scorer", Password: "pw", SSLMode: "disable"}),
+		"custom URL":             "postgres://scorer:pw@db:5432/tickets",
+		"custom with other zone": "postgres://scorer:pw@db:5432/tickets?timezone=America/New_York",
+		"key-value":              "host=db dbname=tickets user=scorer timezone=Europe/Berlin",
+	} {
+		t.Run(name, func(t *testing.T) {
+			connConfig, err := database.PostgresConnConfig(dsn)
+			require.NoError(t, err)
+			assert.Equal(t, "UTC", connConfig.RuntimeParams["timezone"])
+			assert.Equal(t, "tickets", connConfig.Database)
+		})
+	}
+
+	_, err := database.PostgresConnConfig("postgres://db:notaport/tickets")
+	assert.Error(t, err)
+}
+
+func TestOpen_CustomDSN(t *testing.T) {
+	db, dialect, err := database.Open(database.Config{Type: "postgres", DSN: "postgres://scorer:pw@db:5432/tickets"})
+	require.NoError(t, err)
+	assert.Equal(t, repository.Postgres, dialect)
+	require.NoError(t, db.Close())
+
+	db, dialect, err = database.Open(database.Config{Type: "mysql", DSN: "scorer:pw@tcp(db:3306)/tickets"})
+	require.NoError(t, err)
+	assert.Equal(t, repository.MySQL, dialect)
+	require.NoError(t, db.Close())
+
+	_, _, err = database.Open(database.Config{Type: "mysql", DSN: "not a dsn"})
+	assert.Error(t, err)
+}
+
+func mustDSN(t *testing.T, cfg database.Config) string {
+	t.Helper()
+	driver, dsn := database.DSN(cfg, repository.Postgres)
+	require.Equal(t, "pgx", driver)
+	return dsn
+}
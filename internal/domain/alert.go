@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// AlertCondition is what an alert rule compares its threshold with
+type AlertCondition string
+
+const (
+	AlertBelow AlertCondition = "below" // The score of the window is below the threshold
+	AlertAbove AlertCondition = "above" // The score of the window is above the threshold
+	AlertDrop  AlertCondition = "drop"  // The score fell by more than threshold points since the window before
+)
+
+// AlertRule is a condition on the overall score, or that of one category, over
+// the window leading up to each evaluation
+type AlertRule struct {
+	Name       string
+	Category   string // Empty for the overall score
+	Filter     TicketFilter
+	Window     time.Duration
+	Condition  AlertCondition
+	Threshold  float64
+	MinRatings int           // Windows with fewer ratings are not judged, at least 1
+	Cooldown   time.Duration // Least time between two notifications of the rule
+}
+
+// AlertStatus is whether a rule is firing
+type AlertStatus string
+
+const (
+	AlertOK     AlertStatus = "ok"
+	AlertFiring AlertStatus = "firing"
+)
+
+// AlertState is the persisted state of a rule
+type AlertState struct {
+	RuleName       string
+	Status         AlertStatus
+	Value          float64    // Score, or drop in points, of the last evaluation
+	Since          time.Time  // When the rule entered Status
+	LastNotifiedAt *time.Time // Nil until the first notification
+	UpdatedAt      time.Time
+
+	// PendingEvent is the encoded last notification while PendingWebhooks,
+	// the webhooks that failed to receive it, are still to be retried
+	PendingEvent    []byte
+	PendingWebhooks []string
+}
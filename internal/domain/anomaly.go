@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// AnomalySeverity grades how far a score lies outside its expected range. The
+// values match the AnomalySeverity enum of the gRPC API.
+type AnomalySeverity int
+
+const (
+	SeverityMinor    AnomalySeverity = iota // At least the threshold
+	SeverityMajor                           // At least 1.5 times the threshold
+	SeverityCritical                        // At least twice the threshold
+)
+
+const (
+	// DefaultAnomalyThreshold is the robust z-score from which a day is flagged
+	DefaultAnomalyThreshold = 3.5
+	// DefaultBaselineWeeks is how many weeks of the same weekday make a baseline
+	DefaultBaselineWeeks = 8
+)
+
+// AnomalyOptions are the per-request settings of anomaly detection
+type AnomalyOptions struct {
+	Threshold     float64 // See DefaultAnomalyThreshold
+	BaselineWeeks int     // See DefaultBaselineWeeks
+}
+
+// Anomaly is a daily category score outside the range expected from the same
+// weekday of the weeks before
+type Anomaly struct {
+	CategoryName string
+	Day          time.Time
+	Score        float64
+	RatingCount  int
+
+	Expected      float64 // Median score of the baseline days
+	ExpectedLower float64 // Lowest score that would not have been flagged
+	ExpectedUpper float64 // Highest score that would not have been flagged
+	ZScore        float64 // Robust z-score, negative for drops
+	Severity      AnomalySeverity
+	BaselineDays  int // Days with ratings the baseline is made of
+}
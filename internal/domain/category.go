@@ -1,8 +1,32 @@
 package domain
 
+import "time"
+
 type CategoryScore struct {
 	CategoryName string
-	Date         string // YYYY-MM-DD
+	Date         string // Bucket label, see Granularity.Label
 	Score        float64
 	RatingCount  int
+	Granularity  Granularity
+	PeriodStart  time.Time // First day of the bucket within the requested range
+	PeriodEnd    time.Time // Last day of the bucket within the requested range
+	Stats        ScoreStats
+
+	SmoothedScore       float64 // Score after smoothing, equal to Score without smoothing
+	EffectiveSampleSize float64
+	Interval            ConfidenceInterval // Of Score
+
+	// Moving average over the RollingDays days ending with this bucket, including
+	// the days before the requested range
+	RollingScore       float64
+	RollingRatingCount int
+	// Score with every earlier bucket of the category within the requested range
+	// weighted down by its age in days, halving every DecayHalfLifeDays
+	DecayedScore float64
+}
+
+// CategoryComparison compares the score of one category between two periods
+type CategoryComparison struct {
+	CategoryName string
+	PeriodComparisonResult
 }
@@ -0,0 +1,12 @@
+package domain
+
+// DefaultConfidenceLevel is used when a request does not ask for a level
+const DefaultConfidenceLevel = 0.95
+
+// ConfidenceInterval bounds a score, as percentages, at the given confidence
+// level
+type ConfidenceInterval struct {
+	Lower float64
+	Upper float64
+	Level float64 // For example 0.95
+}
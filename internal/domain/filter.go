@@ -0,0 +1,18 @@
+package domain
+
+// TicketFilter restricts scores to the ratings of tickets with the given
+// attributes. Every non-empty field has to match, and within a field any of
+// the values matches.
+type TicketFilter struct {
+	AssigneeIDs []int
+	Teams       []string
+	Channels    []string
+	Priorities  []string
+	Tags        []string // Tickets with at least one of the tags
+}
+
+// IsEmpty reports whether the filter matches every ticket
+func (f TicketFilter) IsEmpty() bool {
+	return len(f.AssigneeIDs) == 0 && len(f.Teams) == 0 && len(f.Channels) == 0 &&
+		len(f.Priorities) == 0 && len(f.Tags) == 0
+}
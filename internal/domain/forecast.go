@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotEnoughHistory is returned when too few weeks have ratings to forecast
+var ErrNotEnoughHistory = errors.New("not enough weeks with ratings for a forecast")
+
+const (
+	// DefaultForecastWeeks is how many weeks are projected when a request does not say
+	DefaultForecastWeeks = 4
+	// DefaultForecastHistoryWeeks is how many complete weeks a forecast is fitted on
+	DefaultForecastHistoryWeeks = 12
+)
+
+// ForecastOptions are the per-request settings of a forecast
+type ForecastOptions struct {
+	Weeks             int     // Weeks to project, starting with the current one
+	HistoryWeeks      int     // Complete weeks before the current one to fit
+	ConfidenceLevel   float64 // Of the prediction intervals
+	IncludeCategories bool
+}
+
+// ForecastPoint is the projected score of one upcoming week
+type ForecastPoint struct {
+	Date        string    // Week label, see Granularity.Label
+	PeriodStart time.Time // Monday of the week
+	PeriodEnd   time.Time // Sunday of the week
+	Score       float64
+	Interval    ConfidenceInterval // Prediction interval of Score
+}
+
+// CategoryForecast is the forecast of a single category
+type CategoryForecast struct {
+	CategoryName string
+	Points       []ForecastPoint
+}
+
+// ScoreForecast projects the weekly overall score and optionally those of the
+// categories. Every category forecast has a point for each week of Overall.
+type ScoreForecast struct {
+	Overall    []ForecastPoint
+	Categories []CategoryForecast // Ordered by name, only categories with enough history
+}
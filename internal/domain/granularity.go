@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Granularity is the size of the time buckets scores are aggregated into.
+// The values match the Granularity enum of the gRPC API.
+type Granularity int
+
+const (
+	GranularityAuto Granularity = iota
+	GranularityDay
+	GranularityWeek
+	GranularityMonth
+	GranularityQuarter
+	GranularityYear
+)
+
+// autoWeeklyThreshold is the number of days above which GranularityAuto
+// switches from daily to weekly buckets
+const autoWeeklyThreshold = 31
+
+func (g Granularity) String() string {
+	switch g {
+	case GranularityAuto:
+		return "auto"
+	case GranularityDay:
+		return "day"
+	case GranularityWeek:
+		return "week"
+	case GranularityMonth:
+		return "month"
+	case GranularityQuarter:
+		return "quarter"
+	case GranularityYear:
+		return "year"
+	default:
+		return fmt.Sprintf("granularity(%d)", int(g))
+	}
+}
+
+// Resolve returns the concrete granularity used for the range [start, end)
+func (g Granularity) Resolve(start, end time.Time) Granularity {
+	if g != GranularityAuto {
+		return g
+	}
+	// Local days are 23 or 25 hours long across DST changes
+	days := math.Round(end.Sub(start).Hours() / 24)
+	if days > autoWeeklyThreshold {
+		return GranularityWeek
+	}
+	return GranularityDay
+}
+
+// BucketStart returns the start of the bucket t falls into, midnight in t's
+// location. Weeks start on Monday.
+func (g Granularity) BucketStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch g {
+	case GranularityWeek:
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case GranularityMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case GranularityQuarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location())
+	case GranularityYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// BucketEnd returns the start of the bucket following the one starting at start
+func (g Granularity) BucketEnd(start time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	case GranularityQuarter:
+		return start.AddDate(0, 3, 0)
+	case GranularityYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Label formats the bucket starting at start, e.g. "2024-05-01", "2024-18"
+// (ISO week), "2024-05", "2024-Q2" or "2024"
+func (g Granularity) Label(start time.Time) string {
+	switch g {
+	case GranularityWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%d-%02d", year, week)
+	case GranularityMonth:
+		return start.Format("2006-01")
+	case GranularityQuarter:
+		return fmt.Sprintf("%d-Q%d", start.Year(), (int(start.Month())-1)/3+1)
+	case GranularityYear:
+		return start.Format("2006")
+	default:
+		return start.Format("2006-01-02")
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// LeaderboardDimension is what a leaderboard ranks. The values match the
+// LeaderboardDimension enum of the gRPC API.
+type LeaderboardDimension int
+
+const (
+	LeaderboardAgents LeaderboardDimension = iota // Ranked by the tickets' assignee
+	LeaderboardTeams
+)
+
+// LeaderboardQuery selects the ratings a leaderboard ranks and how
+type LeaderboardQuery struct {
+	Start          time.Time
+	End            time.Time
+	PreviousStart  time.Time
+	PreviousEnd    time.Time
+	Filter         TicketFilter
+	Dimension      LeaderboardDimension
+	MinRatingCount int  // Agents or teams with fewer ratings are not ranked
+	Limit          int  // Number of entries to return, 0 for all
+	WorstFirst     bool // Return the lowest ranks first
+}
+
+// LeaderboardCategoryScore is the aggregate of one category's ratings for an
+// agent or team
+type LeaderboardCategoryScore struct {
+	Key          string // Assignee ID or team name
+	CategoryName string
+	RatingCount  int
+	WeightedSum  float64
+	TotalWeight  float64
+}
+
+// LeaderboardCategory is one category of a leaderboard entry
+type LeaderboardCategory struct {
+	CategoryName string
+	Score        float64
+	RatingCount  int
+}
+
+// LeaderboardEntry is the ranking of one agent or team
+type LeaderboardEntry struct {
+	Key          string
+	Rank         int // 1 is the best score, ties share a rank
+	Score        float64
+	RatingCount  int
+	Categories   []LeaderboardCategory
+	PreviousRank int // 0 when not ranked in the previous period
+	RankChange   int // Positive when moved up since the previous period
+}
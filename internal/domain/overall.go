@@ -4,13 +4,52 @@ package domain
 type OverallScoreResult struct {
 	Score       float64
 	RatingCount int
+	Stats       ScoreStats
+
+	SmoothedScore       float64 // Score after smoothing, equal to Score without smoothing
+	EffectiveSampleSize float64
+	Interval            ConfidenceInterval // Of Score
+
+	// Score of the RollingDays days up to the end of the range, which reach back
+	// before its start when the range is shorter
+	RollingScore       float64
+	RollingRatingCount int
+	// Score with every rating weighted down by the days between its day and the
+	// last day of the range, halving every DecayHalfLifeDays
+	DecayedScore float64
+}
+
+// BaselineStatus tells whether the previous period of a comparison gives a
+// percentage change. The values match the BaselineStatus enum of the gRPC API.
+type BaselineStatus int
+
+const (
+	BaselineOK        BaselineStatus = iota
+	BaselineNoRatings                // The previous period has no ratings
+	BaselineZeroScore                // The previous score is 0%, so any change is infinitely large
+)
+
+// DefaultSignificanceLevel is the alpha used when a request does not ask for one
+const DefaultSignificanceLevel = 0.05
+
+// ComparisonOptions are the per-request settings of a period comparison
+type ComparisonOptions struct {
+	ConfidenceLevel float64 // Level of the confidence intervals
+	Alpha           float64 // Significance level, see DefaultSignificanceLevel
 }
 
 // PeriodComparisonResult represents the comparison between two time periods
 type PeriodComparisonResult struct {
-	PercentageChange float64
+	PercentageChange float64 // 0 unless Baseline is BaselineOK
+	AbsoluteChange   float64 // Change in percentage points
 	CurrentScore     float64
 	PreviousScore    float64
 	CurrentCount     int
 	PreviousCount    int
+	CurrentInterval  ConfidenceInterval
+	PreviousInterval ConfidenceInterval
+	Baseline         BaselineStatus
+
+	PValue        float64 // Of the two-sample test, 1 when either period has too few ratings
+	IsSignificant bool    // PValue is below the requested alpha
 }
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// PeriodPreset names a current period relative to the present. The values
+// match the PeriodPreset enum of the gRPC API.
+type PeriodPreset int
+
+const (
+	PresetNone          PeriodPreset = iota
+	PresetLast7Days                  // Today and the 6 days before
+	PresetWeekToDate                 // Since Monday
+	PresetMonthToDate                // Since the 1st of the month
+	PresetQuarterToDate              // Since the start of the quarter
+	PresetYearToDate                 // Since January 1st
+	PresetRollingDays                // Today and the N-1 days before
+)
+
+// CompareTo selects the previous period a current period is compared with.
+// The values match the CompareTo enum of the gRPC API.
+type CompareTo int
+
+const (
+	CompareToPreceding          CompareTo = iota // The equivalent period right before
+	CompareToSamePeriodLastYear                  // The same dates one year earlier
+)
+
+// ComparisonPeriods are the half-open ranges of a period comparison
+type ComparisonPeriods struct {
+	CurrentStart  time.Time
+	CurrentEnd    time.Time
+	PreviousStart time.Time
+	PreviousEnd   time.Time
+}
+
+// StartOfDay returns midnight of the day t falls on, in t's location
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	MinRating = 0
+	MaxRating = 5
+)
+
+var (
+	ErrInvalidRating     = errors.New("rating must be between 0 and 5")
+	ErrInvalidTicketID   = errors.New("ticket_id must be a positive number")
+	ErrInvalidCategoryID = errors.New("rating_category_id must be a positive number")
+	ErrUnknownCategory   = errors.New("rating category does not exist or is archived")
+)
+
+// Rating represents a single rating given to a ticket in a category
+type Rating struct {
+	ID               int64
+	TicketID         int
+	RatingCategoryID int
+	Rating           int
+	ReviewerID       int // 0 when unknown
+	RevieweeID       int // 0 when unknown
+	CreatedAt        time.Time
+}
+
+// Validate checks the fields that can be verified without the database
+func (r Rating) Validate() error {
+	if r.Rating < MinRating || r.Rating > MaxRating {
+		return ErrInvalidRating
+	}
+	if r.TicketID <= 0 {
+		return ErrInvalidTicketID
+	}
+	if r.RatingCategoryID <= 0 {
+		return ErrInvalidCategoryID
+	}
+	return nil
+}
+
+// RatingResult is the outcome of storing one rating of a batch
+type RatingResult struct {
+	Index    int
+	RatingID int64
+	Err      error
+}
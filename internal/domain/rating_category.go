@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrCategoryNotFound     = errors.New("rating category not found")
+	ErrCategoryArchived     = errors.New("rating category is archived")
+	ErrInvalidCategoryName  = errors.New("category name must not be empty")
+	ErrInvalidWeight        = errors.New("weight must not be negative")
+	ErrWeightEffectiveOrder = errors.New("weight cannot take effect before the weight currently in effect")
+)
+
+// RatingCategory is a category ratings are given in, together with the weight
+// its ratings carry in weighted scores
+type RatingCategory struct {
+	ID            int
+	Name          string
+	Weight        float64    // Most recently set weight
+	ArchivedAt    *time.Time // nil while the category is active
+	WeightHistory []CategoryWeight
+}
+
+// CategoryWeight is one version of a category weight. A rating is scored with the
+// version whose [EffectiveFrom, EffectiveTo) range contains the rating's creation time.
+type CategoryWeight struct {
+	CategoryID    int
+	Weight        float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time // nil for the version currently in effect
+}
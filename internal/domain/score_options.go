@@ -0,0 +1,10 @@
+package domain
+
+// ScoreOptions are the per-request settings of how scores are reported
+type ScoreOptions struct {
+	Smoothing       Smoothing
+	ConfidenceLevel float64 // Level of the confidence intervals, see DefaultConfidenceLevel
+
+	RollingDays       int     // Length of the moving average window in days, 0 for none
+	DecayHalfLifeDays float64 // Half-life of exponentially decayed scores in days, 0 for none
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// ScoreStats are the sums a weighted score is computed from. Every rating is
+// scaled to [0, 1] and weighted by its category weight.
+type ScoreStats struct {
+	RatingCount        int
+	WeightedSum        float64 // Σ w·x
+	TotalWeight        float64 // Σ w
+	TotalSquaredWeight float64 // Σ w²
+	WeightedSquaredSum float64 // Σ w·x²
+}
+
+// Score returns the weighted mean as a percentage, 0 without any weight
+func (s ScoreStats) Score() float64 {
+	if s.TotalWeight == 0 {
+		return 0
+	}
+	return (s.WeightedSum / s.TotalWeight) * 100
+}
+
+// EffectiveSampleSize returns Kish's effective number of ratings, which is
+// the rating count when all weights are equal and less when they differ
+func (s ScoreStats) EffectiveSampleSize() float64 {
+	if s.TotalSquaredWeight == 0 {
+		return 0
+	}
+	return s.TotalWeight * s.TotalWeight / s.TotalSquaredWeight
+}
+
+// Variance returns the weighted variance of the scaled ratings, 0 without
+// any weight
+func (s ScoreStats) Variance() float64 {
+	if s.TotalWeight == 0 {
+		return 0
+	}
+	mean := s.WeightedSum / s.TotalWeight
+	// Rounding can make a variance of 0 slightly negative
+	return math.Max(0, s.WeightedSquaredSum/s.TotalWeight-mean*mean)
+}
+
+// Add returns the stats of the ratings of s and o together
+func (s ScoreStats) Add(o ScoreStats) ScoreStats {
+	return ScoreStats{
+		RatingCount:        s.RatingCount + o.RatingCount,
+		WeightedSum:        s.WeightedSum + o.WeightedSum,
+		TotalWeight:        s.TotalWeight + o.TotalWeight,
+		TotalSquaredWeight: s.TotalSquaredWeight + o.TotalSquaredWeight,
+		WeightedSquaredSum: s.WeightedSquaredSum + o.WeightedSquaredSum,
+	}
+}
+
+// DailyScoreStats are the stats of the ratings of a single day
+type DailyScoreStats struct {
+	Day   time.Time // Midnight in the time zone of the query
+	Stats ScoreStats
+}
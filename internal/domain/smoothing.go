@@ -0,0 +1,21 @@
+package domain
+
+// SmoothingMode selects what low-volume scores are shrunk toward. The values
+// match the SmoothingMode enum of the gRPC API.
+type SmoothingMode int
+
+const (
+	SmoothingNone SmoothingMode = iota
+	SmoothingPrior
+	SmoothingGlobalMean
+)
+
+// DefaultSmoothingStrength is the prior's weight in ratings when none is given
+const DefaultSmoothingStrength = 10
+
+// Smoothing configures Bayesian smoothing of scores, see scoring.Smooth
+type Smoothing struct {
+	Mode     SmoothingMode
+	Prior    float64 // Percentage to shrink toward with SmoothingPrior
+	Strength float64 // Weight of the prior in ratings
+}
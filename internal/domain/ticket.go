@@ -6,3 +6,9 @@ type TicketCategoryScore struct {
 	CategoryName string
 	Score        float64
 }
+
+// TicketScore holds the category scores of a single ticket
+type TicketScore struct {
+	TicketID       int
+	CategoryScores map[string]float64 // Category name -> percentage score
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// TrendPoint is the score of one bucket of a trend. Buckets without ratings
+// have a RatingCount of 0.
+type TrendPoint struct {
+	Date        string    // Bucket label, see Granularity.Label
+	PeriodStart time.Time // First day of the bucket within the requested range
+	PeriodEnd   time.Time // Last day of the bucket within the requested range
+	Score       float64
+	RatingCount int
+	Stats       ScoreStats
+	Interval    ConfidenceInterval
+}
+
+// CategoryTrend is the trend of a single category
+type CategoryTrend struct {
+	CategoryName string
+	Points       []TrendPoint
+}
+
+// ScoreTrend is a contiguous series of buckets covering a range. Every
+// category trend has a point for each bucket of Overall.
+type ScoreTrend struct {
+	Granularity Granularity
+	Overall     []TrendPoint
+	Categories  []CategoryTrend // Ordered by name, only when requested
+}
@@ -0,0 +1,83 @@
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+type RatingIngester struct {
+	repo repository.RatingRepository
+	now  func() time.Time
+}
+
+func NewRatingIngester(repo repository.RatingRepository) *RatingIngester {
+	return &RatingIngester{repo: repo, now: time.Now}
+}
+
+func (i *RatingIngester) SubmitRating(ctx context.Context, rating domain.Rating) (int64, error) {
+	results, err := i.BatchSubmitRatings(ctx, []domain.Rating{rating})
+	if err != nil {
+		return 0, err
+	}
+	if results[0].Err != nil {
+		return 0, results[0].Err
+	}
+	return results[0].RatingID, nil
+}
+
+// BatchSubmitRatings validates every rating and stores the valid ones.
+// Invalid ratings are reported through their RatingResult; the returned error
+// is only set when storing the valid ratings failed.
+func (i *RatingIngester) BatchSubmitRatings(ctx context.Context, ratings []domain.Rating) ([]domain.RatingResult, error) {
+	results := make([]domain.RatingResult, len(ratings))
+	knownCategories := make(map[int]bool)
+
+	var accepted []domain.Rating
+	var acceptedIdx []int
+	for idx, rating := range ratings {
+		results[idx].Index = idx
+
+		if err := rating.Validate(); err != nil {
+			results[idx].Err = err
+			continue
+		}
+
+		exists, checked := knownCategories[rating.RatingCategoryID]
+		if !checked {
+			var err error
+			exists, err = i.repo.CategoryExists(ctx, rating.RatingCategoryID)
+			if err != nil {
+				return nil, err
+			}
+			knownCategories[rating.RatingCategoryID] = exists
+		}
+		if !exists {
+			results[idx].Err = domain.ErrUnknownCategory
+			continue
+		}
+
+		if rating.CreatedAt.IsZero() {
+			rating.CreatedAt = i.now()
+		}
+		accepted = append(accepted, rating)
+		acceptedIdx = append(acceptedIdx, idx)
+	}
+
+	if len(accepted) == 0 {
+		return results, nil
+	}
+
+	ids, err := i.repo.InsertRatings(ctx, accepted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store ratings: %w", err)
+	}
+	for n, idx := range acceptedIdx {
+		results[idx].RatingID = ids[n]
+	}
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package ingestion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/ingestion"
+)
+
+type mockRatingRepo struct {
+	mock.Mock
+}
+
+func (m *mockRatingRepo) CategoryExists(ctx context.Context, categoryID int) (bool, error) {
+	args := m.Called(ctx, categoryID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockRatingRepo) InsertRatings(ctx context.Context, ratings []domain.Rating) ([]int64, error) {
+	args := m.Called(ctx, ratings)
+	if ids := args.Get(0); ids != nil {
+		return ids.([]int64), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestSubmitRating_Success(t *testing.T) {
+	mockRepo := new(mockRatingRepo)
+	ingester := ingestion.NewRatingIngester(mockRepo)
+
+	rating := domain.Rating{TicketID: 1, RatingCategoryID: 2, Rating: 5, CreatedAt: time.Now()}
+
+	mockRepo.On("CategoryExists", mock.Anything, 2).Return(true, nil)
+	mockRepo.On("InsertRatings", mock.Anything, []domain.Rating{rating}).Return([]int64{42}, nil)
+
+	id, err := ingester.SubmitRating(context.Background(), rating)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSubmitRating_DefaultsCreatedAt(t *testing.T) {
+	mockRepo := new(mockRatingRepo)
+	ingester := ingestion.NewRatingIngester(mockRepo)
+
+	mockRepo.On("CategoryExists", mock.Anything, 2).Return(true, nil)
+	mockRepo.On("InsertRatings", mock.Anything, mock.MatchedBy(func(ratings []domain.Rating) bool {
+		return len(ratings) == 1 && !ratings[0].CreatedAt.IsZero()
+	})).Return([]int64{1}, nil)
+
+	_, err := ingester.SubmitRating(context.Background(), domain.Rating{TicketID: 1, RatingCategoryID: 2, Rating: 3})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSubmitRating_InvalidRating(t *testing.T) {
+	mockRepo := new(mockRatingRepo)
+	ingester := ingestion.NewRatingIngester(mockRepo)
+
+	_, err := ingester.SubmitRating(context.Background(), domain.Rating{TicketID: 1, RatingCategoryID: 2, Rating: 6})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidRating)
+	mockRepo.AssertNotCalled(t, "InsertRatings", mock.Anything, mock.Anything)
+}
+
+func TestBatchSubmitRatings_PartialFailure(t *testing.T) {
+	mockRepo := new(mockRatingRepo)
+	ingester := ingestion.NewRatingIngester(mockRepo)
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ratings := []domain.Rating{
+		{TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+		{TicketID: 0, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+		{TicketID: 2, RatingCategoryID: 9, Rating: 4, CreatedAt: createdAt},
+		{TicketID: 3, RatingCategoryID: 1, Rating: 2, CreatedAt: createdAt},
+	}
+
+	mockRepo.On("CategoryExists", mock.Anything, 1).Return(true, nil).Once()
+	mockRepo.On("CategoryExists", mock.Anything, 9).Return(false, nil).Once()
+	mockRepo.On("InsertRatings", mock.Anything, []domain.Rating{ratings[0], ratings[3]}).Return([]int64{10, 11}, nil)
+
+	results, err := ingester.BatchSubmitRatings(context.Background(), ratings)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.Equal(t, int64(10), results[0].RatingID)
+	assert.ErrorIs(t, results[1].Err, domain.ErrInvalidTicketID)
+	assert.ErrorIs(t, results[2].Err, domain.ErrUnknownCategory)
+	assert.Equal(t, int64(11), results[3].RatingID)
+	assert.NoError(t, results[3].Err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBatchSubmitRatings_InsertError(t *testing.T) {
+	mockRepo := new(mockRatingRepo)
+	ingester := ingestion.NewRatingIngester(mockRepo)
+
+	ratings := []domain.Rating{{TicketID: 1, RatingCategoryID: 1, Rating: 4, CreatedAt: time.Now()}}
+
+	mockRepo.On("CategoryExists", mock.Anything, 1).Return(true, nil)
+	mockRepo.On("InsertRatings", mock.Anything, ratings).Return(nil, errors.New("db error"))
+
+	results, err := ingester.BatchSubmitRatings(context.Background(), ratings)
+
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
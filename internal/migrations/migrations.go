@@ -0,0 +1,234 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ticket-score-engine/internal/repository"
+)
+
+//go:embed sqlite/*.sql postgres/*.sql mysql/*.sql
+var files embed.FS
+
+// Migration is one versioned schema change, read from <version>_<name>.up.sql
+// and <version>_<name>.down.sql in the dialect's directory
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status reports whether a migration has been applied
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    repository.Dialect
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, dialect repository.Dialect) (*Migrator, error) {
+	migrations, err := List(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in version order and returns the applied ones
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if err := m.apply(ctx, migration.Up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.dialect.Rebind(`
+				INSERT INTO schema_version (version, name, applied_at)
+				VALUES (?, ?, ?)`), migration.Version, migration.Name, time.Now().UTC())
+			return err
+		}); err != nil {
+			return done, fmt.Errorf("migration %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down rolls back the most recently applied migration. It returns nil when
+// no migration has been applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if err := m.apply(ctx, migration.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, m.dialect.Rebind(`DELETE FROM schema_version WHERE version = ?`), migration.Version)
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("rollback of %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		return &migration, nil
+	}
+
+	return nil, nil
+}
+
+// Status lists every known migration together with when it was applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// apply runs the statements of a migration script and records the change in the
+// same transaction. MySQL commits DDL implicitly, so a failing MySQL migration
+// may be left partially applied.
+func (m *Migrator) apply(ctx context.Context, script string, record func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range splitStatements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if err := record(tx); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int]time.Time, error) {
+	if _, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_version (
+			version INTEGER PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMP NOT NULL
+		)`); err != nil {
+		return nil, fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_version`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schema_version: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema version: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return applied, nil
+}
+
+// List returns the embedded migrations of a dialect in version order
+func List(dialect repository.Dialect) ([]Migration, error) {
+	dir := dialect.Name()
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s: %w", dir, err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		base := entry.Name()
+		direction := path.Ext(strings.TrimSuffix(base, ".sql"))
+		stem := strings.TrimSuffix(strings.TrimSuffix(base, ".sql"), direction)
+
+		versionPart, name, ok := strings.Cut(stem, "_")
+		if !ok || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("invalid migration file name: %s", base)
+		}
+		version, err := strconv.Atoi(versionPart)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", base, err)
+		}
+
+		content, err := fs.ReadFile(files, path.Join(dir, base))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if direction == ".up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// splitStatements splits a script into its ;-terminated statements. Migration
+// scripts must not contain semicolons inside statements.
+func splitStatements(script string) []string {
+	var stmts []string
+	for _, stmt := range strings.Split(script, ";") {
+		if stmt = strings.TrimSpace(stmt); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	return stmts
+}
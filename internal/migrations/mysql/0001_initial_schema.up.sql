@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS rating_categories (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    weight DOUBLE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    subject TEXT,
+    created_at DATETIME(6)
+);
+
+CREATE TABLE IF NOT EXISTS ratings (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    rating INT NOT NULL,
+    ticket_id INT NOT NULL,
+    rating_category_id INT NOT NULL,
+    reviewer_id INT,
+    reviewee_id INT,
+    created_at DATETIME(6) NOT NULL,
+    INDEX idx_ratings_created_at (created_at),
+    INDEX idx_ratings_ticket_id (ticket_id),
+    FOREIGN KEY (rating_category_id) REFERENCES rating_categories (id)
+);
@@ -0,0 +1,16 @@
+ALTER TABLE rating_categories ADD COLUMN archived_at DATETIME(6);
+
+CREATE TABLE rating_category_weights (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    rating_category_id INT NOT NULL,
+    weight DOUBLE NOT NULL,
+    effective_from DATETIME(6) NOT NULL,
+    effective_to DATETIME(6),
+    INDEX idx_rating_category_weights_category (rating_category_id, effective_from),
+    FOREIGN KEY (rating_category_id) REFERENCES rating_categories (id)
+);
+
+-- The current weight of every category becomes its first version, in effect
+-- since 1970, so historical scores do not change
+INSERT INTO rating_category_weights (rating_category_id, weight, effective_from)
+SELECT id, weight, '1970-01-01 00:00:00' FROM rating_categories;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS ticket_tags;
+ALTER TABLE tickets
+    DROP INDEX idx_tickets_team,
+    DROP INDEX idx_tickets_assignee_id,
+    DROP COLUMN priority,
+    DROP COLUMN channel,
+    DROP COLUMN team,
+    DROP COLUMN assignee_id;
@@ -0,0 +1,15 @@
+ALTER TABLE tickets
+    ADD COLUMN assignee_id INT,
+    ADD COLUMN team VARCHAR(255),
+    ADD COLUMN channel VARCHAR(255),
+    ADD COLUMN priority VARCHAR(64),
+    ADD INDEX idx_tickets_assignee_id (assignee_id),
+    ADD INDEX idx_tickets_team (team);
+
+CREATE TABLE ticket_tags (
+    ticket_id INT NOT NULL,
+    tag VARCHAR(255) NOT NULL,
+    PRIMARY KEY (ticket_id, tag),
+    INDEX idx_ticket_tags_tag (tag),
+    FOREIGN KEY (ticket_id) REFERENCES tickets (id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE alert_states (
+    rule_name VARCHAR(255) PRIMARY KEY,
+    status VARCHAR(16) NOT NULL,
+    value DOUBLE NOT NULL,
+    since DATETIME(6) NOT NULL,
+    last_notified_at DATETIME(6),
+    updated_at DATETIME(6) NOT NULL
+);
@@ -0,0 +1,3 @@
+ALTER TABLE alert_states
+    DROP COLUMN pending_webhooks,
+    DROP COLUMN pending_event;
@@ -0,0 +1,3 @@
+ALTER TABLE alert_states
+    ADD COLUMN pending_event TEXT,
+    ADD COLUMN pending_webhooks TEXT;
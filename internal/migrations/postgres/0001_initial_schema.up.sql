@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS rating_categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    weight DOUBLE PRECISION NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id SERIAL PRIMARY KEY,
+    subject TEXT,
+    created_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS ratings (
+    id BIGSERIAL PRIMARY KEY,
+    rating INTEGER NOT NULL,
+    ticket_id INTEGER NOT NULL,
+    rating_category_id INTEGER NOT NULL REFERENCES rating_categories (id),
+    reviewer_id INTEGER,
+    reviewee_id INTEGER,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ratings_created_at ON ratings (created_at);
+CREATE INDEX IF NOT EXISTS idx_ratings_ticket_id ON ratings (ticket_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS rating_category_weights;
+ALTER TABLE rating_categories DROP COLUMN archived_at;
@@ -0,0 +1,16 @@
+ALTER TABLE rating_categories ADD COLUMN archived_at TIMESTAMPTZ;
+
+CREATE TABLE rating_category_weights (
+    id SERIAL PRIMARY KEY,
+    rating_category_id INTEGER NOT NULL REFERENCES rating_categories (id),
+    weight DOUBLE PRECISION NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ
+);
+
+CREATE INDEX idx_rating_category_weights_category ON rating_category_weights (rating_category_id, effective_from);
+
+-- The current weight of every category becomes its first version, in effect
+-- since 1970, so historical scores do not change
+INSERT INTO rating_category_weights (rating_category_id, weight, effective_from)
+SELECT id, weight, '1970-01-01 00:00:00+00' FROM rating_categories;
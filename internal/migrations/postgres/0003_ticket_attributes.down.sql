@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS ticket_tags;
+DROP INDEX IF EXISTS idx_tickets_team;
+DROP INDEX IF EXISTS idx_tickets_assignee_id;
+ALTER TABLE tickets DROP COLUMN priority;
+ALTER TABLE tickets DROP COLUMN channel;
+ALTER TABLE tickets DROP COLUMN team;
+ALTER TABLE tickets DROP COLUMN assignee_id;
@@ -0,0 +1,8 @@
+CREATE TABLE alert_states (
+    rule_name TEXT PRIMARY KEY,
+    status TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    since TIMESTAMPTZ NOT NULL,
+    last_notified_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL
+);
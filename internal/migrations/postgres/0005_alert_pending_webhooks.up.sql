@@ -0,0 +1,2 @@
+ALTER TABLE alert_states ADD COLUMN pending_event TEXT;
+ALTER TABLE alert_states ADD COLUMN pending_webhooks TEXT;
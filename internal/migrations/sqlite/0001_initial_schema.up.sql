@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS rating_categories (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    weight REAL NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    subject TEXT,
+    created_at DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS ratings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    rating INTEGER NOT NULL,
+    ticket_id INTEGER NOT NULL,
+    rating_category_id INTEGER NOT NULL REFERENCES rating_categories (id),
+    reviewer_id INTEGER,
+    reviewee_id INTEGER,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ratings_created_at ON ratings (created_at);
+CREATE INDEX IF NOT EXISTS idx_ratings_ticket_id ON ratings (ticket_id);
@@ -0,0 +1,15 @@
+ALTER TABLE tickets ADD COLUMN assignee_id INTEGER;
+ALTER TABLE tickets ADD COLUMN team TEXT;
+ALTER TABLE tickets ADD COLUMN channel TEXT;
+ALTER TABLE tickets ADD COLUMN priority TEXT;
+
+CREATE INDEX idx_tickets_assignee_id ON tickets (assignee_id);
+CREATE INDEX idx_tickets_team ON tickets (team);
+
+CREATE TABLE ticket_tags (
+    ticket_id INTEGER NOT NULL REFERENCES tickets (id),
+    tag TEXT NOT NULL,
+    PRIMARY KEY (ticket_id, tag)
+);
+
+CREATE INDEX idx_ticket_tags_tag ON ticket_tags (tag);
@@ -0,0 +1,8 @@
+CREATE TABLE alert_states (
+    rule_name TEXT PRIMARY KEY,
+    status TEXT NOT NULL,
+    value REAL NOT NULL,
+    since DATETIME NOT NULL,
+    last_notified_at DATETIME,
+    updated_at DATETIME NOT NULL
+);
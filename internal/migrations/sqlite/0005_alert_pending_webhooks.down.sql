@@ -0,0 +1,2 @@
+ALTER TABLE alert_states DROP COLUMN pending_webhooks;
+ALTER TABLE alert_states DROP COLUMN pending_event;
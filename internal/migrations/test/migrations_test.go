@@ -0,0 +1,82 @@
+package migrations_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/migrations"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsExistForEveryDialect(t *testing.T) {
+	var versions []int
+	for _, dialect := range []repository.Dialect{repository.SQLite, repository.Postgres, repository.MySQL} {
+		statuses, err := migrations.List(dialect)
+		require.NoError(t, err)
+		require.NotEmpty(t, statuses)
+
+		var dialectVersions []int
+		for _, m := range statuses {
+			require.NotEmpty(t, m.Up, "%s %04d has no up script", dialect.Name(), m.Version)
+			require.NotEmpty(t, m.Down, "%s %04d has no down script", dialect.Name(), m.Version)
+			dialectVersions = append(dialectVersions, m.Version)
+		}
+		if versions == nil {
+			versions = dialectVersions
+		}
+		require.Equal(t, versions, dialectVersions, "%s migrations differ from sqlite", dialect.Name())
+	}
+}
+
+func TestSQLite_UpDownStatus(t *testing.T) {
+	ctx := context.Background()
+
+	db, dialect, err := database.Open(database.Config{
+		Type: "sqlite",
+		Path: filepath.Join(t.TempDir(), "migrate.db"),
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator, err := migrations.NewMigrator(db, dialect)
+	require.NoError(t, err)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, s := range statuses {
+		require.Nil(t, s.AppliedAt)
+	}
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, len(statuses))
+
+	// Schema is usable once migrated
+	_, err = db.Exec(`INSERT INTO rating_categories (name, weight) VALUES ('Spelling', 1)`)
+	require.NoError(t, err)
+
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	require.Empty(t, applied)
+
+	reverted, err := migrator.Down(ctx)
+	require.NoError(t, err)
+	require.Equal(t, statuses[len(statuses)-1].Version, reverted.Version)
+
+	statuses, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Nil(t, statuses[len(statuses)-1].AppliedAt)
+	require.NotNil(t, statuses[0].AppliedAt)
+
+	for range statuses[:len(statuses)-1] {
+		_, err = migrator.Down(ctx)
+		require.NoError(t, err)
+	}
+	reverted, err = migrator.Down(ctx)
+	require.NoError(t, err)
+	require.Nil(t, reverted)
+}
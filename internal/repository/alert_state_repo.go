@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"ticket-score-engine/internal/domain"
+)
+
+type AlertStateRepository interface {
+	ListAlertStates(ctx context.Context) ([]domain.AlertState, error)
+	// SaveAlertState inserts the state of a rule or replaces its previous one
+	SaveAlertState(ctx context.Context, state domain.AlertState) error
+}
+
+type alertStateRepo struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+func NewAlertStateRepository(db *sql.DB, dialect Dialect) AlertStateRepository {
+	return &alertStateRepo{db: db, dialect: dialect}
+}
+
+func (r *alertStateRepo) ListAlertStates(ctx context.Context) ([]domain.AlertState, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT rule_name, status, value, since, last_notified_at, updated_at, pending_event, pending_webhooks
+		FROM alert_states
+		ORDER BY rule_name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alert states: %w", err)
+	}
+	defer rows.Close()
+
+	var states []domain.AlertState
+	for rows.Next() {
+		var state domain.AlertState
+		var lastNotifiedAt sql.NullTime
+		var pendingEvent, pendingWebhooks sql.NullString
+
+		if err := rows.Scan(&state.RuleName, &state.Status, &state.Value, &state.Since, &lastNotifiedAt, &state.UpdatedAt, &pendingEvent, &pendingWebhooks); err != nil {
+			return nil, fmt.Errorf("failed to scan alert state: %w", err)
+		}
+		state.Since = state.Since.UTC()
+		state.UpdatedAt = state.UpdatedAt.UTC()
+		if lastNotifiedAt.Valid {
+			notifiedAt := lastNotifiedAt.Time.UTC()
+			state.LastNotifiedAt = &notifiedAt
+		}
+		if pendingEvent.Valid {
+			state.PendingEvent = []byte(pendingEvent.String)
+		}
+		if pendingWebhooks.Valid {
+			if err := json.Unmarshal([]byte(pendingWebhooks.String), &state.PendingWebhooks); err != nil {
+				return nil, fmt.Errorf("invalid pending webhooks of %q: %w", state.RuleName, err)
+			}
+		}
+
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return states, nil
+}
+
+// SaveAlertState updates the row of the rule and inserts it when there is none.
+// Rules are evaluated by a single alerter, so the two statements do not race.
+func (r *alertStateRepo) SaveAlertState(ctx context.Context, state domain.AlertState) error {
+	var lastNotifiedAt any
+	if state.LastNotifiedAt != nil {
+		lastNotifiedAt = state.LastNotifiedAt.UTC()
+	}
+	// Both are NULL once every webhook has received the last notification
+	var pendingEvent, pendingWebhooks any
+	if len(state.PendingWebhooks) > 0 {
+		webhooks, err := json.Marshal(state.PendingWebhooks)
+		if err != nil {
+			return fmt.Errorf("failed to encode pending webhooks: %w", err)
+		}
+		pendingEvent, pendingWebhooks = string(state.PendingEvent), string(webhooks)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, r.dialect.Rebind(`
+		UPDATE alert_states
+		SET status = ?, value = ?, since = ?, last_notified_at = ?, updated_at = ?, pending_event = ?, pending_webhooks = ?
+		WHERE rule_name = ?`),
+		string(state.Status), state.Value, state.Since.UTC(), lastNotifiedAt, state.UpdatedAt.UTC(), pendingEvent, pendingWebhooks, state.RuleName)
+	if err != nil {
+		return fmt.Errorf("failed to update alert state: %w", err)
+	}
+
+	// MySQL counts matched rows that kept their values as unaffected, so only
+	// insert when the rule has no row at all
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		var exists int
+		if err := tx.QueryRowContext(ctx, r.dialect.Rebind(`
+			SELECT COUNT(1) FROM alert_states WHERE rule_name = ?`), state.RuleName).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check alert state: %w", err)
+		}
+		if exists == 0 {
+			if _, err := tx.ExecContext(ctx, r.dialect.Rebind(`
+				INSERT INTO alert_states (rule_name, status, value, since, last_notified_at, updated_at, pending_event, pending_webhooks)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+				state.RuleName, string(state.Status), state.Value, state.Since.UTC(), lastNotifiedAt, state.UpdatedAt.UTC(), pendingEvent, pendingWebhooks); err != nil {
+				return fmt.Errorf("failed to insert alert state: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit alert state: %w", err)
+	}
+	return nil
+}
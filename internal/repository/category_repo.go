@@ -10,50 +10,45 @@ import (
 )
 
 type CategoryRepository interface {
-	GetCategoryScores(ctx context.Context, start, end time.Time) ([]domain.CategoryScore, error)
+	// GetCategoryScores buckets the ratings of tickets matching filter made in
+	// [start, end) by calendar periods of the given time zone
+	GetCategoryScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, granularity domain.Granularity, loc *time.Location) ([]domain.CategoryScore, error)
+	// GetCategoryTotals aggregates the ratings of tickets matching filter made
+	// in [start, end) per category over the whole range, ordered by category
+	GetCategoryTotals(ctx context.Context, start, end time.Time, filter domain.TicketFilter) ([]domain.CategoryScore, error)
 }
 
 type categoryRepo struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect Dialect
 }
 
-func NewCategoryRepository(db *sql.DB) CategoryRepository {
-	return &categoryRepo{db: db}
+func NewCategoryRepository(db *sql.DB, dialect Dialect) CategoryRepository {
+	return &categoryRepo{db: db, dialect: dialect}
 }
 
-func (r *categoryRepo) GetCategoryScores(ctx context.Context, start, end time.Time) ([]domain.CategoryScore, error) {
-	isWeekly := end.Sub(start) > 30*24*time.Hour
-
-	var query string
-	if isWeekly {
-		query = `
-			SELECT 
-				rc.name AS category,
-				STRFTIME('%Y-%V', r.created_at) as period,
-				COUNT(r.id) as count,
-				SUM((r.rating * 1.0 / 5.0) * rc.weight) as weighted_score,
-				SUM(rc.weight) as total_weight
-			FROM ratings r
-			JOIN rating_categories rc ON r.rating_category_id = rc.id
-			WHERE r.created_at BETWEEN ? AND ?
-			GROUP BY rc.name, STRFTIME('%Y-%V', r.created_at)
-			ORDER BY rc.name, period`
-	} else {
-		query = `
-			SELECT 
-				rc.name AS category,
-				DATE(r.created_at) as period,
-				COUNT(r.id) as count,
-				SUM((r.rating * 1.0 / 5.0) * rc.weight) as weighted_score,
-				SUM(rc.weight) as total_weight
-			FROM ratings r
-			JOIN rating_categories rc ON r.rating_category_id = rc.id
-			WHERE r.created_at BETWEEN ? AND ?
-			GROUP BY rc.name, DATE(r.created_at)
-			ORDER BY rc.name, period`
-	}
+func (r *categoryRepo) GetCategoryScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, granularity domain.Granularity, loc *time.Location) ([]domain.CategoryScore, error) {
+	granularity = granularity.Resolve(start, end)
+	createdAt, args := localTime(r.dialect, "r.created_at", loc, start, end)
+	period := r.dialect.DateBucket(createdAt, granularity)
+	filterJoin, filterWhere, filterArgs := ticketFilter(filter)
+
+	query := `
+		SELECT 
+			rc.name AS category,
+			` + period + ` as period,
+			COUNT(r.id) as count,
+			SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_score,
+			SUM(` + ratingWeight + `) as total_weight,
+			SUM(` + ratingWeight + ` * ` + ratingWeight + `) as total_squared_weight,
+			SUM((r.rating * 1.0 / 5.0) * (r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_squared_score` + weightedRatings + filterJoin + `
+		WHERE r.created_at >= ? AND r.created_at < ?` + filterWhere + `
+		GROUP BY rc.name, period
+		ORDER BY rc.name, period`
 
-	rows, err := r.db.QueryContext(ctx, query, start, end)
+	args = append(args, start, end)
+	args = append(args, filterArgs...)
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query category scores: %w", err)
 	}
@@ -61,24 +56,75 @@ func (r *categoryRepo) GetCategoryScores(ctx context.Context, start, end time.Ti
 
 	var scores []domain.CategoryScore
 	for rows.Next() {
-		var cs domain.CategoryScore
-		var weightedSum, totalWeight float64
+		cs := domain.CategoryScore{Granularity: granularity}
+		var period string
 
 		if err := rows.Scan(
 			&cs.CategoryName,
-			&cs.Date,
+			&period,
 			&cs.RatingCount,
-			&weightedSum,
-			&totalWeight,
+			&cs.Stats.WeightedSum,
+			&cs.Stats.TotalWeight,
+			&cs.Stats.TotalSquaredWeight,
+			&cs.Stats.WeightedSquaredSum,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan category score: %w", err)
 		}
 
-		if totalWeight > 0 {
-			cs.Score = (weightedSum / totalWeight) * 100
+		cs.Stats.RatingCount = cs.RatingCount
+		cs.Score = cs.Stats.Score()
+		if err := setPeriod(&cs, period, start.In(loc), end.In(loc)); err != nil {
+			return nil, err
+		}
+
+		scores = append(scores, cs)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return scores, nil
+}
+
+func (r *categoryRepo) GetCategoryTotals(ctx context.Context, start, end time.Time, filter domain.TicketFilter) ([]domain.CategoryScore, error) {
+	filterJoin, filterWhere, filterArgs := ticketFilter(filter)
+
+	query := `
+		SELECT 
+			rc.name AS category,
+			COUNT(r.id) as count,
+			SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_score,
+			SUM(` + ratingWeight + `) as total_weight,
+			SUM(` + ratingWeight + ` * ` + ratingWeight + `) as total_squared_weight,
+			SUM((r.rating * 1.0 / 5.0) * (r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_squared_score` + weightedRatings + filterJoin + `
+		WHERE r.created_at >= ? AND r.created_at < ?` + filterWhere + `
+		GROUP BY rc.name
+		ORDER BY rc.name`
+
+	args := append([]any{start, end}, filterArgs...)
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query category totals: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []domain.CategoryScore
+	for rows.Next() {
+		var cs domain.CategoryScore
+		if err := rows.Scan(
+			&cs.CategoryName,
+			&cs.RatingCount,
+			&cs.Stats.WeightedSum,
+			&cs.Stats.TotalWeight,
+			&cs.Stats.TotalSquaredWeight,
+			&cs.Stats.WeightedSquaredSum,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan category total: %w", err)
 		}
-		//cs.IsWeekly = isWeekly
 
+		cs.Stats.RatingCount = cs.RatingCount
+		cs.Score = cs.Stats.Score()
 		scores = append(scores, cs)
 	}
 
@@ -88,3 +134,26 @@ func (r *categoryRepo) GetCategoryScores(ctx context.Context, start, end time.Ti
 
 	return scores, nil
 }
+
+// setPeriod fills in the label and the day range of the bucket starting at period,
+// clipped to the requested range [start, end). Days are those of the location of
+// start and end.
+func setPeriod(cs *domain.CategoryScore, period string, start, end time.Time) error {
+	bucketStart, err := time.ParseInLocation("2006-01-02", period, start.Location())
+	if err != nil {
+		return fmt.Errorf("failed to parse period %q: %w", period, err)
+	}
+	bucketEnd := cs.Granularity.BucketEnd(bucketStart).AddDate(0, 0, -1)
+
+	cs.Date = cs.Granularity.Label(bucketStart)
+	cs.PeriodStart = bucketStart
+	if first := domain.StartOfDay(start); first.After(bucketStart) {
+		cs.PeriodStart = first
+	}
+	cs.PeriodEnd = bucketEnd
+	if last := domain.StartOfDay(end.Add(-time.Nanosecond)); last.Before(bucketEnd) {
+		cs.PeriodEnd = last
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// Dialect captures the SQL differences between the supported databases.
+// Repositories write their queries with ? placeholders and SQLite-neutral SQL,
+// and ask the dialect for everything that is database specific.
+type Dialect interface {
+	Name() string
+	// Rebind rewrites ? placeholders into the placeholder style of the database
+	Rebind(query string) string
+	// DateBucket formats the first day of the bucket a timestamp expression falls
+	// into as "YYYY-MM-DD". Weeks start on Monday.
+	DateBucket(expr string, granularity domain.Granularity) string
+	// ShiftSeconds adds an integer number of seconds to a timestamp expression
+	ShiftSeconds(expr, seconds string) string
+	// ReturnsInsertID reports whether inserted IDs must be read with RETURNING
+	// because the driver does not support LastInsertId
+	ReturnsInsertID() bool
+}
+
+var (
+	SQLite   Dialect = sqliteDialect{}
+	Postgres Dialect = postgresDialect{}
+	MySQL    Dialect = mysqlDialect{}
+)
+
+// DialectByName returns the dialect for a DB_TYPE value
+func DialectByName(name string) (Dialect, error) {
+	switch strings.ToLower(name) {
+	case "", "sqlite", "sqlite3":
+		return SQLite, nil
+	case "postgres", "postgresql":
+		return Postgres, nil
+	case "mysql", "mariadb":
+		return MySQL, nil
+	default:
+		return nil, fmt.Errorf("unsupported database type: %s", name)
+	}
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string               { return "sqlite" }
+func (sqliteDialect) Rebind(query string) string { return query }
+func (sqliteDialect) ReturnsInsertID() bool      { return false }
+
+func (sqliteDialect) ShiftSeconds(expr, seconds string) string {
+	return "DATETIME(CAST(STRFTIME('%s', " + expr + ") AS INTEGER) + (" + seconds + "), 'unixepoch')"
+}
+
+func (sqliteDialect) DateBucket(expr string, granularity domain.Granularity) string {
+	switch granularity {
+	case domain.GranularityWeek:
+		return "DATE(" + expr + ", 'weekday 0', '-6 days')"
+	case domain.GranularityMonth:
+		return "DATE(" + expr + ", 'start of month')"
+	case domain.GranularityQuarter:
+		return "DATE(" + expr + ", 'start of month', '-' || ((CAST(STRFTIME('%m', " + expr + ") AS INTEGER) - 1) % 3) || ' months')"
+	case domain.GranularityYear:
+		return "DATE(" + expr + ", 'start of year')"
+	default:
+		return "DATE(" + expr + ")"
+	}
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string               { return "postgres" }
+func (postgresDialect) Rebind(query string) string { return numberPlaceholders(query) }
+func (postgresDialect) ReturnsInsertID() bool      { return true }
+
+func (postgresDialect) ShiftSeconds(expr, seconds string) string {
+	return "(" + expr + " + (" + seconds + ") * INTERVAL '1 second')"
+}
+
+func (postgresDialect) DateBucket(expr string, granularity domain.Granularity) string {
+	switch granularity {
+	case domain.GranularityWeek, domain.GranularityMonth, domain.GranularityQuarter, domain.GranularityYear:
+		return "TO_CHAR(DATE_TRUNC('" + granularity.String() + "', " + expr + "), 'YYYY-MM-DD')"
+	default:
+		return "TO_CHAR(" + expr + ", 'YYYY-MM-DD')"
+	}
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string               { return "mysql" }
+func (mysqlDialect) Rebind(query string) string { return query }
+func (mysqlDialect) ReturnsInsertID() bool      { return false }
+
+func (mysqlDialect) ShiftSeconds(expr, seconds string) string {
+	return "DATE_ADD(" + expr + ", INTERVAL (" + seconds + ") SECOND)"
+}
+
+func (mysqlDialect) DateBucket(expr string, granularity domain.Granularity) string {
+	switch granularity {
+	case domain.GranularityWeek:
+		return "DATE_FORMAT(DATE_SUB(" + expr + ", INTERVAL WEEKDAY(" + expr + ") DAY), '%Y-%m-%d')"
+	case domain.GranularityMonth:
+		return "DATE_FORMAT(" + expr + ", '%Y-%m-01')"
+	case domain.GranularityQuarter:
+		return "DATE_FORMAT(MAKEDATE(YEAR(" + expr + "), 1) + INTERVAL (QUARTER(" + expr + ") - 1) QUARTER, '%Y-%m-%d')"
+	case domain.GranularityYear:
+		return "DATE_FORMAT(" + expr + ", '%Y-01-01')"
+	default:
+		return "DATE_FORMAT(" + expr + ", '%Y-%m-%d')"
+	}
+}
+
+// numberPlaceholders replaces every ? outside of string literals with $1, $2, ...
+func numberPlaceholders(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	n := 0
+	inString := false
+	for _, c := range query {
+		switch {
+		case c == '\'':
+			inString = !inString
+		case c == '?' && !inString:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+type execQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// insertReturningID runs an INSERT into a table with an "id" primary key and
+// returns the generated ID in the way the dialect supports
+func insertReturningID(ctx context.Context, db execQuerier, dialect Dialect, query string, args ...any) (int64, error) {
+	if dialect.ReturnsInsertID() {
+		var id int64
+		err := db.QueryRowContext(ctx, dialect.Rebind(query+" RETURNING id"), args...).Scan(&id)
+		return id, err
+	}
+
+	res, err := db.ExecContext(ctx, dialect.Rebind(query), args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
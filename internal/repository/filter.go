@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"strings"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// ticketsJoin makes the tickets of ratings available as t
+const ticketsJoin = `
+		JOIN tickets t ON t.id = r.ticket_id`
+
+// ticketFilter translates f into the conditions to append to the WHERE clause
+// of a query over weightedRatings, together with their arguments. join is
+// ticketsJoin when the conditions need it, and empty otherwise.
+func ticketFilter(f domain.TicketFilter) (join, where string, args []any) {
+	var conditions []string
+	in := func(column string, values []any) {
+		if len(values) == 0 {
+			return
+		}
+		conditions = append(conditions, column+" IN ("+placeholders(len(values))+")")
+		args = append(args, values...)
+	}
+
+	in("t.assignee_id", toArgs(f.AssigneeIDs))
+	in("t.team", toArgs(f.Teams))
+	in("t.channel", toArgs(f.Channels))
+	in("t.priority", toArgs(f.Priorities))
+	if len(conditions) > 0 {
+		join = ticketsJoin
+	}
+
+	if len(f.Tags) > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_tags tt WHERE tt.ticket_id = r.ticket_id AND tt.tag IN ("+placeholders(len(f.Tags))+"))")
+		args = append(args, toArgs(f.Tags)...)
+	}
+
+	for _, c := range conditions {
+		where += `
+		AND ` + c
+	}
+	return join, where, args
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func toArgs[T any](values []T) []any {
+	args := make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
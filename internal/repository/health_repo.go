@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type HealthRepository interface {
+	// Ping checks that the database can be reached
+	Ping(ctx context.Context) error
+}
+
+type healthRepo struct {
+	db *sql.DB
+}
+
+func NewHealthRepository(db *sql.DB) HealthRepository {
+	return &healthRepo{db: db}
+}
+
+func (r *healthRepo) Ping(ctx context.Context) error {
+	if err := r.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+)
+
+type LeaderboardRepository interface {
+	// GetLeaderboardScores aggregates the ratings of tickets matching filter made
+	// in [start, end) per agent or team and category, ordered by both. Tickets
+	// without an assignee or team are left out.
+	GetLeaderboardScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, dimension domain.LeaderboardDimension) ([]domain.LeaderboardCategoryScore, error)
+}
+
+type leaderboardRepo struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+func NewLeaderboardRepository(db *sql.DB, dialect Dialect) LeaderboardRepository {
+	return &leaderboardRepo{db: db, dialect: dialect}
+}
+
+func (r *leaderboardRepo) GetLeaderboardScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, dimension domain.LeaderboardDimension) ([]domain.LeaderboardCategoryScore, error) {
+	var key string
+	switch dimension {
+	case domain.LeaderboardAgents:
+		key = "t.assignee_id"
+	case domain.LeaderboardTeams:
+		key = "t.team"
+	default:
+		return nil, fmt.Errorf("unsupported leaderboard dimension: %d", dimension)
+	}
+
+	// The tickets are always joined to group by them
+	_, filterWhere, filterArgs := ticketFilter(filter)
+	query := `
+		SELECT 
+			` + key + ` AS entity,
+			rc.name AS category,
+			COUNT(r.id) as count,
+			SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_score,
+			SUM(` + ratingWeight + `) as total_weight` + weightedRatings + ticketsJoin + `
+		WHERE r.created_at >= ? AND r.created_at < ?
+		AND ` + key + ` IS NOT NULL` + filterWhere + `
+		GROUP BY ` + key + `, rc.name
+		ORDER BY ` + key + `, rc.name`
+
+	args := append([]any{start, end}, filterArgs...)
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leaderboard scores: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []domain.LeaderboardCategoryScore
+	for rows.Next() {
+		var s domain.LeaderboardCategoryScore
+		if err := rows.Scan(&s.Key, &s.CategoryName, &s.RatingCount, &s.WeightedSum, &s.TotalWeight); err != nil {
+			return nil, fmt.Errorf("failed to scan leaderboard score: %w", err)
+		}
+		scores = append(scores, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return scores, nil
+}
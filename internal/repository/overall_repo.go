@@ -5,49 +5,115 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
+
+	"ticket-score-engine/internal/domain"
 )
 
 type OverallRepository interface {
-	GetOverallScore(ctx context.Context, start, end time.Time) (float64, int, error)
+	// GetOverallScore aggregates the ratings of tickets matching filter made in [start, end)
+	GetOverallScore(ctx context.Context, start, end time.Time, filter domain.TicketFilter) (domain.ScoreStats, error)
+	// GetDailyScores aggregates the same ratings per day of loc, ordered by day.
+	// Days without ratings are left out.
+	GetDailyScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, loc *time.Location) ([]domain.DailyScoreStats, error)
 }
 
 type overallRepo struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect Dialect
 }
 
-func NewOverallRepository(db *sql.DB) OverallRepository {
-	return &overallRepo{db: db}
+func NewOverallRepository(db *sql.DB, dialect Dialect) OverallRepository {
+	return &overallRepo{db: db, dialect: dialect}
 }
 
-func (r *overallRepo) GetOverallScore(ctx context.Context, start, end time.Time) (float64, int, error) {
+func (r *overallRepo) GetOverallScore(ctx context.Context, start, end time.Time, filter domain.TicketFilter) (domain.ScoreStats, error) {
+	filterJoin, filterWhere, filterArgs := ticketFilter(filter)
 	query := `
         SELECT 
-            SUM((r.rating * 1.0 / 5.0) * rc.weight) as total_weighted_score,
-            SUM(rc.weight) as total_weight,
-            COUNT(r.id) as rating_count
-        FROM ratings r
-        JOIN rating_categories rc ON r.rating_category_id = rc.id
-        WHERE r.created_at BETWEEN ? AND ?;
+            SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as total_weighted_score,
+            SUM(` + ratingWeight + `) as total_weight,
+            COUNT(r.id) as rating_count,
+            SUM(` + ratingWeight + ` * ` + ratingWeight + `) as total_squared_weight,
+            SUM((r.rating * 1.0 / 5.0) * (r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_squared_score` + weightedRatings + filterJoin + `
+        WHERE r.created_at >= ? AND r.created_at < ?` + filterWhere + `;
     `
 
+	// The sums are NULL when no rating matches
 	var (
-		totalWeightedScore float64
-		totalWeight        float64
+		totalWeightedScore sql.NullFloat64
+		totalWeight        sql.NullFloat64
 		ratingCount        int
+		totalSquaredWeight sql.NullFloat64
+		weightedSquared    sql.NullFloat64
 	)
 
-	err := r.db.QueryRowContext(ctx, query, start, end).Scan(&totalWeightedScore, &totalWeight, &ratingCount)
+	args := append([]any{start, end}, filterArgs...)
+	err := r.db.QueryRowContext(ctx, r.dialect.Rebind(query), args...).Scan(&totalWeightedScore, &totalWeight, &ratingCount, &totalSquaredWeight, &weightedSquared)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return 0, 0, nil
+			return domain.ScoreStats{}, nil
+		}
+		return domain.ScoreStats{}, fmt.Errorf("query error: %w", err)
+	}
+
+	return domain.ScoreStats{
+		RatingCount:        ratingCount,
+		WeightedSum:        totalWeightedScore.Float64,
+		TotalWeight:        totalWeight.Float64,
+		TotalSquaredWeight: totalSquaredWeight.Float64,
+		WeightedSquaredSum: weightedSquared.Float64,
+	}, nil
+}
+
+func (r *overallRepo) GetDailyScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, loc *time.Location) ([]domain.DailyScoreStats, error) {
+	createdAt, args := localTime(r.dialect, "r.created_at", loc, start, end)
+	day := r.dialect.DateBucket(createdAt, domain.GranularityDay)
+	filterJoin, filterWhere, filterArgs := ticketFilter(filter)
+
+	query := `
+		SELECT 
+			` + day + ` as day,
+			COUNT(r.id) as rating_count,
+			SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as total_weighted_score,
+			SUM(` + ratingWeight + `) as total_weight,
+			SUM(` + ratingWeight + ` * ` + ratingWeight + `) as total_squared_weight,
+			SUM((r.rating * 1.0 / 5.0) * (r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_squared_score` + weightedRatings + filterJoin + `
+		WHERE r.created_at >= ? AND r.created_at < ?` + filterWhere + `
+		GROUP BY day
+		ORDER BY day`
+
+	args = append(args, start, end)
+	args = append(args, filterArgs...)
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daily scores: %w", err)
+	}
+	defer rows.Close()
+
+	var days []domain.DailyScoreStats
+	for rows.Next() {
+		var ds domain.DailyScoreStats
+		var day string
+		if err := rows.Scan(
+			&day,
+			&ds.Stats.RatingCount,
+			&ds.Stats.WeightedSum,
+			&ds.Stats.TotalWeight,
+			&ds.Stats.TotalSquaredWeight,
+			&ds.Stats.WeightedSquaredSum,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan daily score: %w", err)
+		}
+
+		if ds.Day, err = time.ParseInLocation("2006-01-02", day, loc); err != nil {
+			return nil, fmt.Errorf("failed to parse day %q: %w", day, err)
 		}
-		return 0, 0, fmt.Errorf("query error: %w", err)
+		days = append(days, ds)
 	}
 
-	if totalWeight == 0 {
-		return 0, ratingCount, nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	score := (totalWeightedScore / totalWeight) * 100
-	return score, ratingCount, nil
+	return days, nil
 }
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// weightedRatings joins every rating with its category and with the category weight
+// that was in effect when the rating was made. Categories whose weight was never
+// versioned fall back to rating_categories.weight.
+const weightedRatings = `
+	FROM ratings r
+	JOIN rating_categories rc ON r.rating_category_id = rc.id
+	LEFT JOIN rating_category_weights w ON w.rating_category_id = r.rating_category_id
+		AND w.effective_from <= r.created_at
+		AND (w.effective_to IS NULL OR r.created_at < w.effective_to)`
+
+// ratingWeight is the weight a rating carries, to be used with weightedRatings
+const ratingWeight = `COALESCE(w.weight, rc.weight)`
+
+// weightHistoryStart is the effective date given to the weight a category had
+// before its weight was first versioned, so that it covers every older rating
+var weightHistoryStart = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type RatingCategoryRepository interface {
+	ListCategories(ctx context.Context, includeArchived bool) ([]domain.RatingCategory, error)
+	GetCategory(ctx context.Context, id int) (*domain.RatingCategory, error)
+	ListWeightHistory(ctx context.Context) ([]domain.CategoryWeight, error)
+	CreateCategory(ctx context.Context, name string, weight float64, effectiveFrom time.Time) (int, error)
+	RenameCategory(ctx context.Context, id int, name string) error
+	UpdateCategoryWeight(ctx context.Context, id int, weight float64, effectiveFrom time.Time) error
+	ArchiveCategory(ctx context.Context, id int, archivedAt time.Time) error
+}
+
+type ratingCategoryRepo struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+func NewRatingCategoryRepository(db *sql.DB, dialect Dialect) RatingCategoryRepository {
+	return &ratingCategoryRepo{db: db, dialect: dialect}
+}
+
+func (r *ratingCategoryRepo) ListCategories(ctx context.Context, includeArchived bool) ([]domain.RatingCategory, error) {
+	query := `
+		SELECT id, name, weight, archived_at
+		FROM rating_categories`
+	if !includeArchived {
+		query += `
+		WHERE archived_at IS NULL`
+	}
+	query += `
+		ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []domain.RatingCategory
+	for rows.Next() {
+		category, err := scanRatingCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, *category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *ratingCategoryRepo) GetCategory(ctx context.Context, id int) (*domain.RatingCategory, error) {
+	query := `
+		SELECT id, name, weight, archived_at
+		FROM rating_categories
+		WHERE id = ?`
+
+	category, err := scanRatingCategory(r.db.QueryRowContext(ctx, r.dialect.Rebind(query), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return category, err
+}
+
+func (r *ratingCategoryRepo) ListWeightHistory(ctx context.Context) ([]domain.CategoryWeight, error) {
+	query := `
+		SELECT rating_category_id, weight, effective_from, effective_to
+		FROM rating_category_weights
+		ORDER BY rating_category_id, effective_from`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query weight history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []domain.CategoryWeight
+	for rows.Next() {
+		var cw domain.CategoryWeight
+		var effectiveTo sql.NullTime
+
+		if err := rows.Scan(&cw.CategoryID, &cw.Weight, &cw.EffectiveFrom, &effectiveTo); err != nil {
+			return nil, fmt.Errorf("failed to scan category weight: %w", err)
+		}
+		if effectiveTo.Valid {
+			cw.EffectiveTo = &effectiveTo.Time
+		}
+
+		history = append(history, cw)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return history, nil
+}
+
+func (r *ratingCategoryRepo) CreateCategory(ctx context.Context, name string, weight float64, effectiveFrom time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	id, err := insertReturningID(ctx, tx, r.dialect, `INSERT INTO rating_categories (name, weight) VALUES (?, ?)`, name, weight)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert rating category: %w", err)
+	}
+
+	if err := r.insertCategoryWeight(ctx, tx, int(id), weight, effectiveFrom); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit rating category: %w", err)
+	}
+
+	return int(id), nil
+}
+
+func (r *ratingCategoryRepo) RenameCategory(ctx context.Context, id int, name string) error {
+	res, err := r.db.ExecContext(ctx, r.dialect.Rebind(`UPDATE rating_categories SET name = ? WHERE id = ?`), name, id)
+	if err != nil {
+		return fmt.Errorf("failed to rename rating category: %w", err)
+	}
+	return expectAffected(res)
+}
+
+// UpdateCategoryWeight closes the weight version currently in effect at effectiveFrom
+// and opens a new one, leaving scores of older ratings untouched
+func (r *ratingCategoryRepo) UpdateCategoryWeight(ctx context.Context, id int, weight float64, effectiveFrom time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var currentWeight float64
+	err = tx.QueryRowContext(ctx, r.dialect.Rebind(`SELECT weight FROM rating_categories WHERE id = ?`), id).Scan(&currentWeight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up rating category: %w", err)
+	}
+
+	var currentFrom time.Time
+	err = tx.QueryRowContext(ctx, r.dialect.Rebind(`
+		SELECT effective_from
+		FROM rating_category_weights
+		WHERE rating_category_id = ? AND effective_to IS NULL`), id).Scan(&currentFrom)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// Weight was never versioned; record the existing weight as the first version
+		if err := r.insertCategoryWeight(ctx, tx, id, currentWeight, weightHistoryStart); err != nil {
+			return err
+		}
+		currentFrom = weightHistoryStart
+	case err != nil:
+		return fmt.Errorf("failed to look up current weight: %w", err)
+	}
+
+	if !effectiveFrom.After(currentFrom) {
+		return domain.ErrWeightEffectiveOrder
+	}
+
+	if _, err := tx.ExecContext(ctx, r.dialect.Rebind(`
+		UPDATE rating_category_weights
+		SET effective_to = ?
+		WHERE rating_category_id = ? AND effective_to IS NULL`), effectiveFrom.UTC(), id); err != nil {
+		return fmt.Errorf("failed to close current weight: %w", err)
+	}
+	if err := r.insertCategoryWeight(ctx, tx, id, weight, effectiveFrom); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, r.dialect.Rebind(`UPDATE rating_categories SET weight = ? WHERE id = ?`), weight, id); err != nil {
+		return fmt.Errorf("failed to update rating category weight: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit weight change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ratingCategoryRepo) ArchiveCategory(ctx context.Context, id int, archivedAt time.Time) error {
+	res, err := r.db.ExecContext(ctx, r.dialect.Rebind(`
+		UPDATE rating_categories
+		SET archived_at = ?
+		WHERE id = ? AND archived_at IS NULL`), archivedAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to archive rating category: %w", err)
+	}
+	return expectAffected(res)
+}
+
+func (r *ratingCategoryRepo) insertCategoryWeight(ctx context.Context, tx *sql.Tx, id int, weight float64, effectiveFrom time.Time) error {
+	_, err := tx.ExecContext(ctx, r.dialect.Rebind(`
+		INSERT INTO rating_category_weights (rating_category_id, weight, effective_from)
+		VALUES (?, ?, ?)`), id, weight, effectiveFrom.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert category weight: %w", err)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRatingCategory(row rowScanner) (*domain.RatingCategory, error) {
+	var category domain.RatingCategory
+	var archivedAt sql.NullTime
+
+	if err := row.Scan(&category.ID, &category.Name, &category.Weight, &archivedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan rating category: %w", err)
+	}
+	if archivedAt.Valid {
+		category.ArchivedAt = &archivedAt.Time
+	}
+
+	return &category, nil
+}
+
+func expectAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
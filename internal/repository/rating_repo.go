@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"ticket-score-engine/internal/domain"
+)
+
+type RatingRepository interface {
+	// CategoryExists reports whether the category exists and still accepts ratings
+	CategoryExists(ctx context.Context, categoryID int) (bool, error)
+	InsertRatings(ctx context.Context, ratings []domain.Rating) ([]int64, error)
+}
+
+type ratingRepo struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+func NewRatingRepository(db *sql.DB, dialect Dialect) RatingRepository {
+	return &ratingRepo{db: db, dialect: dialect}
+}
+
+func (r *ratingRepo) CategoryExists(ctx context.Context, categoryID int) (bool, error) {
+	query := `SELECT COUNT(1) FROM rating_categories WHERE id = ? AND archived_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, r.dialect.Rebind(query), categoryID).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to look up rating category: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// InsertRatings stores all ratings in a single transaction and returns their IDs
+// in the same order. Either every rating is stored or none is.
+func (r *ratingRepo) InsertRatings(ctx context.Context, ratings []domain.Rating) ([]int64, error) {
+	query := `
+		INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids := make([]int64, 0, len(ratings))
+	for _, rating := range ratings {
+		id, err := insertReturningID(ctx, tx, r.dialect, query,
+			rating.Rating,
+			rating.TicketID,
+			rating.RatingCategoryID,
+			nullableID(rating.ReviewerID),
+			nullableID(rating.RevieweeID),
+			rating.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert rating: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit ratings: %w", err)
+	}
+
+	return ids, nil
+}
+
+// nullableID maps the zero value of an optional foreign key to NULL
+func nullableID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id > 0}
+}
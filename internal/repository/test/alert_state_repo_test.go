@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLite_SaveAlertStateInsertsThenReplaces(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewAlertStateRepository(newSQLiteDB(t), repository.SQLite)
+
+	firedAt := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SaveAlertState(ctx, domain.AlertState{
+		RuleName:       "overall-24h",
+		Status:         domain.AlertFiring,
+		Value:          40,
+		Since:          firedAt,
+		LastNotifiedAt: &firedAt,
+		UpdatedAt:      firedAt,
+	}))
+	require.NoError(t, repo.SaveAlertState(ctx, domain.AlertState{
+		RuleName:        "grammar-wow",
+		Status:          domain.AlertOK,
+		Value:           2.5,
+		Since:           firedAt,
+		UpdatedAt:       firedAt,
+		PendingEvent:    []byte(`{"rule":"grammar-wow"}`),
+		PendingWebhooks: []string{"https://hooks.example.com/a", "https://hooks.example.com/b"},
+	}))
+
+	resolvedAt := firedAt.Add(time.Hour)
+	require.NoError(t, repo.SaveAlertState(ctx, domain.AlertState{
+		RuleName:       "overall-24h",
+		Status:         domain.AlertOK,
+		Value:          88,
+		Since:          resolvedAt,
+		LastNotifiedAt: &resolvedAt,
+		UpdatedAt:      resolvedAt,
+	}))
+
+	states, err := repo.ListAlertStates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []domain.AlertState{
+		{
+			RuleName:        "grammar-wow",
+			Status:          domain.AlertOK,
+			Value:           2.5,
+			Since:           firedAt,
+			UpdatedAt:       firedAt,
+			PendingEvent:    []byte(`{"rule":"grammar-wow"}`),
+			PendingWebhooks: []string{"https://hooks.example.com/a", "https://hooks.example.com/b"},
+		},
+		{RuleName: "overall-24h", Status: domain.AlertOK, Value: 88, Since: resolvedAt, LastNotifiedAt: &resolvedAt, UpdatedAt: resolvedAt},
+	}, states)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"ticket-score-engine/internal/domain"
 	"ticket-score-engine/internal/repository"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -20,17 +21,17 @@ func TestGetCategoryScores(t *testing.T) {
 	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) // Less than 30 days => daily
 
 	rows := sqlmock.NewRows([]string{
-		"category", "period", "count", "weighted_score", "total_weight",
+		"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
 	}).AddRow(
-		"Support", "2024-01-02", 10, 45.0, 5.0,
+		"Support", "2024-01-02", 10, 45.0, 5.0, 5.0, 45.0,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM ratings").
 		WithArgs(start, end).
 		WillReturnRows(rows)
 
-	repo := repository.NewCategoryRepository(db)
-	scores, err := repo.GetCategoryScores(context.Background(), start, end)
+	repo := repository.NewCategoryRepository(db, repository.SQLite)
+	scores, err := repo.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityAuto, time.UTC)
 
 	assert.NoError(t, err)
 	assert.Len(t, scores, 1)
@@ -38,4 +39,66 @@ func TestGetCategoryScores(t *testing.T) {
 	assert.Equal(t, "2024-01-02", scores[0].Date)
 	assert.Equal(t, 10, scores[0].RatingCount)
 	assert.InDelta(t, 900.0, scores[0].Score, 0.01) // (45/5)*100 = 900
+	assert.Equal(t, domain.GranularityDay, scores[0].Granularity)
+}
+
+func TestGetCategoryScores_ClipsBucketsToRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 8, 21, 0, 0, 0, 0, time.UTC) // exclusive, the last day is August 20th
+
+	rows := sqlmock.NewRows([]string{
+		"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
+	}).
+		AddRow("Support", "2024-04-01", 3, 2.0, 3.0, 3.0, 2.0).
+		AddRow("Support", "2024-07-01", 4, 2.0, 4.0, 4.0, 2.0)
+
+	mock.ExpectQuery("SELECT .* FROM ratings").
+		WithArgs(start, end).
+		WillReturnRows(rows)
+
+	repo := repository.NewCategoryRepository(db, repository.SQLite)
+	scores, err := repo.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityQuarter, time.UTC)
+
+	assert.NoError(t, err)
+	assert.Len(t, scores, 2)
+	assert.Equal(t, "2024-Q2", scores[0].Date)
+	assert.Equal(t, start, scores[0].PeriodStart)
+	assert.Equal(t, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), scores[0].PeriodEnd)
+	assert.Equal(t, "2024-Q3", scores[1].Date)
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), scores[1].PeriodStart)
+	assert.Equal(t, time.Date(2024, 8, 20, 0, 0, 0, 0, time.UTC), scores[1].PeriodEnd)
+}
+
+func TestGetCategoryTotals(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// One row per category, whatever the length of the range
+	mock.ExpectQuery(`SELECT .* FROM ratings .*GROUP BY rc.name\s+ORDER BY rc.name`).
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"category", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
+		}).
+			AddRow("GDPR", 4, 3.0, 4.0, 4.0, 3.0).
+			AddRow("Support", 2, 1.0, 2.0, 2.0, 1.0))
+
+	repo := repository.NewCategoryRepository(db, repository.SQLite)
+	scores, err := repo.GetCategoryTotals(context.Background(), start, end, domain.TicketFilter{})
+
+	assert.NoError(t, err)
+	assert.Len(t, scores, 2)
+	assert.Equal(t, "GDPR", scores[0].CategoryName)
+	assert.Equal(t, 4, scores[0].RatingCount)
+	assert.InDelta(t, 75.0, scores[0].Score, 0.01)
+	assert.Equal(t, 4, scores[0].Stats.RatingCount)
+	assert.InDelta(t, 50.0, scores[1].Score, 0.01)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
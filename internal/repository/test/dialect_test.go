@@ -0,0 +1,218 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectByName(t *testing.T) {
+	for name, expected := range map[string]repository.Dialect{
+		"":         repository.SQLite,
+		"sqlite":   repository.SQLite,
+		"postgres": repository.Postgres,
+		"MySQL":    repository.MySQL,
+	} {
+		dialect, err := repository.DialectByName(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, dialect)
+	}
+
+	_, err := repository.DialectByName("oracle")
+	assert.Error(t, err)
+}
+
+func TestPostgresRebind(t *testing.T) {
+	query := repository.Postgres.Rebind("SELECT '?' FROM t WHERE a = ? AND b BETWEEN ? AND ?")
+	assert.Equal(t, "SELECT '?' FROM t WHERE a = $1 AND b BETWEEN $2 AND $3", query)
+}
+
+func TestGetCategoryScores_PerDialect(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		dialect repository.Dialect
+		query   string
+	}{
+		{repository.SQLite, `DATE\(r.created_at\) as period.*r.created_at >= \? AND r.created_at < \?`},
+		{repository.Postgres, `TO_CHAR\(r.created_at, 'YYYY-MM-DD'\) as period.*r.created_at >= \$1 AND r.created_at < \$2`},
+		{repository.MySQL, `DATE_FORMAT\(r.created_at, '%Y-%m-%d'\) as period.*r.created_at >= \? AND r.created_at < \?`},
+	} {
+		t.Run(tc.dialect.Name(), func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(tc.query).
+				WithArgs(start, end).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
+				}).AddRow("Support", "2024-01-02", 2, 1.6, 2.0, 2.0, 1.6))
+
+			repo := repository.NewCategoryRepository(db, tc.dialect)
+			scores, err := repo.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC)
+
+			assert.NoError(t, err)
+			assert.Len(t, scores, 1)
+			assert.InDelta(t, 80.0, scores[0].Score, 0.01)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetCategoryScores_WeeklyPerDialect(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		dialect repository.Dialect
+		period  string
+	}{
+		{repository.SQLite, `DATE\(r.created_at, 'weekday 0', '-6 days'\)`},
+		{repository.Postgres, `TO_CHAR\(DATE_TRUNC\('week', r.created_at\), 'YYYY-MM-DD'\)`},
+		{repository.MySQL, `DATE_FORMAT\(DATE_SUB\(r.created_at, INTERVAL WEEKDAY\(r.created_at\) DAY\), '%Y-%m-%d'\)`},
+	} {
+		t.Run(tc.dialect.Name(), func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(tc.period+` as period.*GROUP BY rc.name, period`).
+				WithArgs(start, end).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
+				}))
+
+			repo := repository.NewCategoryRepository(db, tc.dialect)
+			_, err = repo.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityAuto, time.UTC)
+
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInsertRatings_PostgresReturning(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO ratings .* VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\) RETURNING id`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	repo := repository.NewRatingRepository(db, repository.Postgres)
+	ids, err := repo.InsertRatings(context.Background(), []domain.Rating{
+		{TicketID: 1, RatingCategoryID: 1, Rating: 3, CreatedAt: createdAt},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCategoryScores_DSTOffsets(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	start := time.Date(2024, 3, 4, 0, 0, 0, 0, newYork).UTC()
+	end := time.Date(2024, 3, 15, 0, 0, 0, 0, newYork).UTC()
+	transition := time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC) // 02:00 EST
+
+	for _, tc := range []struct {
+		dialect repository.Dialect
+		shifted string
+	}{
+		{repository.SQLite, `DATE\(DATETIME\(CAST\(STRFTIME\('%s', r.created_at\) AS INTEGER\) \+ \(CASE WHEN r.created_at < \? THEN -18000 ELSE -14400 END\), 'unixepoch'\)\)`},
+		{repository.Postgres, `TO_CHAR\(\(r.created_at \+ \(CASE WHEN r.created_at < \$1 THEN -18000 ELSE -14400 END\) \* INTERVAL '1 second'\), 'YYYY-MM-DD'\)`},
+		{repository.MySQL, `DATE_FORMAT\(DATE_ADD\(r.created_at, INTERVAL \(CASE WHEN r.created_at < \? THEN -18000 ELSE -14400 END\) SECOND\), '%Y-%m-%d'\)`},
+	} {
+		t.Run(tc.dialect.Name(), func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(tc.shifted+` as period`).
+				WithArgs(transition, start, end).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score",
+				}).AddRow("Support", "2024-03-10", 1, 1.0, 1.0, 1.0, 1.0))
+
+			repo := repository.NewCategoryRepository(db, tc.dialect)
+			scores, err := repo.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, newYork)
+
+			assert.NoError(t, err)
+			assert.Len(t, scores, 1)
+			assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, newYork), scores[0].PeriodStart)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetOverallScore_TicketFilter(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.TicketFilter{
+		AssigneeIDs: []int{7, 9},
+		Teams:       []string{"billing"},
+		Tags:        []string{"vip", "escalated"},
+	}
+
+	for _, tc := range []struct {
+		dialect repository.Dialect
+		query   string
+	}{
+		{repository.SQLite, `JOIN tickets t ON t.id = r.ticket_id\s+WHERE r.created_at >= \? AND r.created_at < \?\s+AND t.assignee_id IN \(\?, \?\)\s+AND t.team IN \(\?\)\s+AND EXISTS \(SELECT 1 FROM ticket_tags tt WHERE tt.ticket_id = r.ticket_id AND tt.tag IN \(\?, \?\)\)`},
+		{repository.Postgres, `JOIN tickets t ON t.id = r.ticket_id\s+WHERE r.created_at >= \$1 AND r.created_at < \$2\s+AND t.assignee_id IN \(\$3, \$4\)\s+AND t.team IN \(\$5\)\s+AND EXISTS \(SELECT 1 FROM ticket_tags tt WHERE tt.ticket_id = r.ticket_id AND tt.tag IN \(\$6, \$7\)\)`},
+	} {
+		t.Run(tc.dialect.Name(), func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(tc.query).
+				WithArgs(start, end, 7, 9, "billing", "vip", "escalated").
+				WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+					AddRow(1.0, 2.0, 2, 2.0, 1.0))
+
+			repo := repository.NewOverallRepository(db, tc.dialect)
+			stats, err := repo.GetOverallScore(context.Background(), start, end, filter)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, stats.RatingCount)
+			assert.InDelta(t, 50.0, stats.Score(), 0.01)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetOverallScore_TagFilterOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// Tags alone need no join on tickets
+	mock.ExpectQuery(`AND \(w.effective_to IS NULL OR r.created_at < w.effective_to\)\s+WHERE r.created_at >= \? AND r.created_at < \?\s+AND EXISTS`).
+		WithArgs(start, end, "vip").
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(1.0, 1.0, 1, 1.0, 1.0))
+
+	repo := repository.NewOverallRepository(db, repository.MySQL)
+	_, err = repo.GetOverallScore(context.Background(), start, end, domain.TicketFilter{Tags: []string{"vip"}})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
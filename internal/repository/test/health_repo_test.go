@@ -0,0 +1,33 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ticket-score-engine/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRepository_Ping(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewHealthRepository(db)
+
+	mock.ExpectPing()
+	require.NoError(t, repo.Ping(context.Background()))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	require.ErrorContains(t, repo.Ping(context.Background()), "failed to ping database")
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLite_Ping(t *testing.T) {
+	repo := repository.NewHealthRepository(newSQLiteDB(t))
+	require.NoError(t, repo.Ping(context.Background()))
+}
@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLeaderboardScores_Agents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT\s+t.assignee_id AS entity,.*JOIN tickets t ON t.id = r.ticket_id\s+WHERE r.created_at >= \? AND r.created_at < \?\s+AND t.assignee_id IS NOT NULL\s+GROUP BY t.assignee_id, rc.name`).
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"entity", "category", "count", "weighted_score", "total_weight",
+		}).
+			AddRow(7, "GDPR", 3, 2.0, 3.0).
+			AddRow(7, "Tone", 1, 1.0, 1.0))
+
+	repo := repository.NewLeaderboardRepository(db, repository.SQLite)
+	scores, err := repo.GetLeaderboardScores(context.Background(), start, end, domain.TicketFilter{}, domain.LeaderboardAgents)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LeaderboardCategoryScore{
+		{Key: "7", CategoryName: "GDPR", RatingCount: 3, WeightedSum: 2.0, TotalWeight: 3.0},
+		{Key: "7", CategoryName: "Tone", RatingCount: 1, WeightedSum: 1.0, TotalWeight: 1.0},
+	}, scores)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetLeaderboardScores_TeamsWithFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// The filter reuses the join the leaderboard needs anyway
+	mock.ExpectQuery(`JOIN tickets t ON t.id = r.ticket_id\s+WHERE r.created_at >= \$1 AND r.created_at < \$2\s+AND t.team IS NOT NULL\s+AND t.channel IN \(\$3\)\s+GROUP BY t.team, rc.name`).
+		WithArgs(start, end, "chat").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"entity", "category", "count", "weighted_score", "total_weight",
+		}).AddRow("billing", "GDPR", 3, 2.0, 3.0))
+
+	repo := repository.NewLeaderboardRepository(db, repository.Postgres)
+	scores, err := repo.GetLeaderboardScores(context.Background(), start, end,
+		domain.TicketFilter{Channels: []string{"chat"}}, domain.LeaderboardTeams)
+
+	assert.NoError(t, err)
+	assert.Len(t, scores, 1)
+	assert.Equal(t, "billing", scores[0].Key)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"ticket-score-engine/internal/domain"
 	"ticket-score-engine/internal/repository"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -20,22 +21,22 @@ func TestGetOverallScore_Success(t *testing.T) {
 	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	end := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
 
-	repo := repository.NewOverallRepository(db)
+	repo := repository.NewOverallRepository(db, repository.SQLite)
 
 	totalWeightedScore := 75.0
 	totalWeight := 100.0
 	ratingCount := 15
 
-	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* rc.weight\\)").
+	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* COALESCE\\(w.weight, rc.weight\\)\\)").
 		WithArgs(start, end).
-		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count"}).
-			AddRow(totalWeightedScore, totalWeight, ratingCount))
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(totalWeightedScore, totalWeight, ratingCount, totalWeight, totalWeightedScore))
 
-	score, count, err := repo.GetOverallScore(context.Background(), start, end)
+	stats, err := repo.GetOverallScore(context.Background(), start, end, domain.TicketFilter{})
 
 	assert.NoError(t, err)
-	assert.Equal(t, ratingCount, count)
-	assert.InDelta(t, 75.0, score, 0.01)
+	assert.Equal(t, ratingCount, stats.RatingCount)
+	assert.InDelta(t, 75.0, stats.Score(), 0.01)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -48,18 +49,18 @@ func TestGetOverallScore_ZeroWeight(t *testing.T) {
 	start := time.Now().AddDate(0, -1, 0)
 	end := time.Now()
 
-	repo := repository.NewOverallRepository(db)
+	repo := repository.NewOverallRepository(db, repository.SQLite)
 
-	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* rc.weight\\)").
+	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* COALESCE\\(w.weight, rc.weight\\)\\)").
 		WithArgs(start, end).
-		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count"}).
-			AddRow(0.0, 0.0, 10))
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(0.0, 0.0, 10, 0.0, 0.0))
 
-	score, count, err := repo.GetOverallScore(context.Background(), start, end)
+	stats, err := repo.GetOverallScore(context.Background(), start, end, domain.TicketFilter{})
 
 	assert.NoError(t, err)
-	assert.Equal(t, 10, count)
-	assert.Equal(t, float64(0), score)
+	assert.Equal(t, 10, stats.RatingCount)
+	assert.Equal(t, float64(0), stats.Score())
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -72,17 +73,17 @@ func TestGetOverallScore_QueryError(t *testing.T) {
 	start := time.Now().AddDate(0, -1, 0)
 	end := time.Now()
 
-	repo := repository.NewOverallRepository(db)
+	repo := repository.NewOverallRepository(db, repository.SQLite)
 
-	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* rc.weight\\)").
+	mock.ExpectQuery("SELECT SUM\\(\\(r.rating \\* 1.0 / 5.0\\) \\* COALESCE\\(w.weight, rc.weight\\)\\)").
 		WithArgs(start, end).
 		WillReturnError(sql.ErrConnDone)
 
-	score, count, err := repo.GetOverallScore(context.Background(), start, end)
+	stats, err := repo.GetOverallScore(context.Background(), start, end, domain.TicketFilter{})
 
 	assert.Error(t, err)
-	assert.Equal(t, float64(0), score)
-	assert.Equal(t, 0, count)
+	assert.Equal(t, float64(0), stats.Score())
+	assert.Equal(t, 0, stats.RatingCount)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCategories_ExcludesArchived(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id, name, weight, archived_at FROM rating_categories WHERE archived_at IS NULL").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "weight", "archived_at"}).
+			AddRow(1, "Spelling", 1.0, nil).
+			AddRow(2, "GDPR", 0.5, nil))
+
+	repo := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	categories, err := repo.ListCategories(context.Background(), false)
+
+	assert.NoError(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, "GDPR", categories[1].Name)
+	assert.Equal(t, 0.5, categories[1].Weight)
+	assert.Nil(t, categories[1].ArchivedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCategory_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id, name, weight, archived_at FROM rating_categories").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "weight", "archived_at"}))
+
+	repo := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	category, err := repo.GetCategory(context.Background(), 9)
+
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+	assert.Nil(t, category)
+}
+
+func TestUpdateCategoryWeight_VersionsUnversionedWeight(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	effectiveFrom := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT weight FROM rating_categories").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"weight"}).AddRow(1.0))
+	mock.ExpectQuery("SELECT effective_from FROM rating_category_weights").
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT INTO rating_category_weights").
+		WithArgs(1, 1.0, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE rating_category_weights SET effective_to").
+		WithArgs(effectiveFrom, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO rating_category_weights").
+		WithArgs(1, 0.5, effectiveFrom).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("UPDATE rating_categories SET weight").
+		WithArgs(0.5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	err = repo.UpdateCategoryWeight(context.Background(), 1, 0.5, effectiveFrom)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateCategoryWeight_RejectsBackdatingBeforeCurrentWeight(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	currentFrom := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT weight FROM rating_categories").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"weight"}).AddRow(0.5))
+	mock.ExpectQuery("SELECT effective_from FROM rating_category_weights").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"effective_from"}).AddRow(currentFrom))
+	mock.ExpectRollback()
+
+	repo := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	err = repo.UpdateCategoryWeight(context.Background(), 1, 0.8, currentFrom.AddDate(0, 0, -1))
+
+	assert.ErrorIs(t, err, domain.ErrWeightEffectiveOrder)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
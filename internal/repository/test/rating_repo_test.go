@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT COUNT\\(1\\) FROM rating_categories").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	repo := repository.NewRatingRepository(db, repository.SQLite)
+	exists, err := repo.CategoryExists(context.Background(), 3)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInsertRatings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ratings := []domain.Rating{
+		{TicketID: 101, RatingCategoryID: 1, Rating: 4, ReviewerID: 7, CreatedAt: createdAt},
+		{TicketID: 102, RatingCategoryID: 2, Rating: 0, CreatedAt: createdAt},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO ratings").
+		WithArgs(4, 101, 1, sql.NullInt64{Int64: 7, Valid: true}, sql.NullInt64{}, createdAt).
+		WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.ExpectExec("INSERT INTO ratings").
+		WithArgs(0, 102, 2, sql.NullInt64{}, sql.NullInt64{}, createdAt).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectCommit()
+
+	repo := repository.NewRatingRepository(db, repository.SQLite)
+	ids, err := repo.InsertRatings(context.Background(), ratings)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{11, 12}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInsertRatings_RollsBackOnError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO ratings").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	repo := repository.NewRatingRepository(db, repository.SQLite)
+	ids, err := repo.InsertRatings(context.Background(), []domain.Rating{
+		{TicketID: 101, RatingCategoryID: 1, Rating: 4, CreatedAt: createdAt},
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
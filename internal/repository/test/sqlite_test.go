@@ -0,0 +1,448 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ticket-score-engine/internal/database"
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/migrations"
+	"ticket-score-engine/internal/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newSQLiteDB opens an empty embedded SQLite database with all migrations applied
+func newSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, dialect, err := database.Open(database.Config{
+		Type: "sqlite",
+		Path: filepath.Join(t.TempDir(), "test.db"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migrations.NewMigrator(db, dialect)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestSQLite_ScoresUseWeightInEffect(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categories := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	ratings := repository.NewRatingRepository(db, repository.SQLite)
+
+	day := func(d, h int) time.Time { return time.Date(2024, 5, d, h, 0, 0, 0, time.UTC) }
+
+	spelling, err := categories.CreateCategory(ctx, "Spelling", 1, day(1, 0))
+	require.NoError(t, err)
+	gdpr, err := categories.CreateCategory(ctx, "GDPR", 1, day(1, 0))
+	require.NoError(t, err)
+
+	_, err = ratings.InsertRatings(ctx, []domain.Rating{
+		{TicketID: 1, RatingCategoryID: spelling, Rating: 5, CreatedAt: day(2, 9)},
+		{TicketID: 1, RatingCategoryID: gdpr, Rating: 0, CreatedAt: day(2, 10)},
+		{TicketID: 2, RatingCategoryID: spelling, Rating: 5, CreatedAt: day(4, 9)},
+		{TicketID: 2, RatingCategoryID: gdpr, Rating: 0, CreatedAt: day(4, 10)},
+	})
+	require.NoError(t, err)
+
+	// GDPR counts three times as much from May 3rd onwards
+	require.NoError(t, categories.UpdateCategoryWeight(ctx, gdpr, 3, day(3, 0)))
+
+	overall := repository.NewOverallRepository(db, repository.SQLite)
+
+	stats, err := overall.GetOverallScore(ctx, day(1, 0), day(3, 0), domain.TicketFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.RatingCount)
+	require.InDelta(t, 50.0, stats.Score(), 0.01)
+	// One 5-star and one 0-star rating
+	require.InDelta(t, 0.25, stats.Variance(), 0.0001)
+
+	stats, err = overall.GetOverallScore(ctx, day(3, 0), day(5, 0), domain.TicketFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.RatingCount)
+	require.InDelta(t, 25.0, stats.Score(), 0.01)
+	// Weights 1 and 3 are worth (1+3)²/(1²+3²) equally weighted ratings
+	require.InDelta(t, 1.6, stats.EffectiveSampleSize(), 0.0001)
+
+	categoryScores, err := repository.NewCategoryRepository(db, repository.SQLite).
+		GetCategoryScores(ctx, day(1, 0), day(5, 0), domain.TicketFilter{}, domain.GranularityDay, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, categoryScores, 4)
+	require.Equal(t, "GDPR", categoryScores[0].CategoryName)
+	require.Equal(t, "2024-05-02", categoryScores[0].Date)
+
+	totals, err := repository.NewCategoryRepository(db, repository.SQLite).
+		GetCategoryTotals(ctx, day(1, 0), day(5, 0), domain.TicketFilter{})
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+	require.Equal(t, "GDPR", totals[0].CategoryName)
+	require.Equal(t, 2, totals[0].RatingCount)
+	require.InDelta(t, 0.0, totals[0].Score, 0.01)
+	require.Equal(t, "Spelling", totals[1].CategoryName)
+	require.InDelta(t, 100.0, totals[1].Score, 0.01)
+	require.InDelta(t, 0.0, totals[1].Stats.Variance(), 0.0001)
+
+	ticketScores, err := scanAllScoresByTicket(ctx, repository.NewTicketRepository(db, repository.SQLite),
+		day(1, 0), day(5, 0), domain.TicketFilter{}, 0)
+	require.NoError(t, err)
+	require.Len(t, ticketScores, 4)
+	require.Equal(t, 1, ticketScores[0].TicketID)
+}
+
+func TestSQLite_CategoryLifecycle(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	repo := repository.NewRatingCategoryRepository(db, repository.SQLite)
+	ratings := repository.NewRatingRepository(db, repository.SQLite)
+
+	id, err := repo.CreateCategory(ctx, "Tone", 0.5, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.NoError(t, repo.RenameCategory(ctx, id, "Tone of voice"))
+
+	exists, err := ratings.CategoryExists(ctx, id)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, repo.ArchiveCategory(ctx, id, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	exists, err = ratings.CategoryExists(ctx, id)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	active, err := repo.ListCategories(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, active)
+
+	all, err := repo.ListCategories(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, "Tone of voice", all[0].Name)
+	require.NotNil(t, all[0].ArchivedAt)
+
+	history, err := repo.ListWeightHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Nil(t, history[0].EffectiveTo)
+}
+
+func TestSQLite_CategoryScoreBuckets(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	var ratings []domain.Rating
+	for _, createdAt := range []time.Time{
+		time.Date(2024, 12, 29, 12, 0, 0, 0, time.UTC), // Sunday, ISO week 2024-52
+		time.Date(2024, 12, 30, 12, 0, 0, 0, time.UTC), // Monday, ISO week 2025-01
+		time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC),   // Thursday, ISO week 2025-01
+		time.Date(2025, 2, 14, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC),
+	} {
+		ratings = append(ratings, domain.Rating{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: createdAt})
+	}
+	_, err = repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, ratings)
+	require.NoError(t, err)
+
+	repo := repository.NewCategoryRepository(db, repository.SQLite)
+	start := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		granularity domain.Granularity
+		labels      []string
+		counts      []int
+	}{
+		{domain.GranularityWeek, []string{"2024-52", "2025-01", "2025-07", "2025-14"}, []int{1, 2, 1, 1}},
+		{domain.GranularityMonth, []string{"2024-12", "2025-01", "2025-02", "2025-04"}, []int{2, 1, 1, 1}},
+		{domain.GranularityQuarter, []string{"2024-Q4", "2025-Q1", "2025-Q2"}, []int{2, 2, 1}},
+		{domain.GranularityYear, []string{"2024", "2025"}, []int{2, 3}},
+	} {
+		t.Run(tc.granularity.String(), func(t *testing.T) {
+			scores, err := repo.GetCategoryScores(ctx, start, end, domain.TicketFilter{}, tc.granularity, time.UTC)
+			require.NoError(t, err)
+
+			var labels []string
+			var counts []int
+			for _, s := range scores {
+				labels = append(labels, s.Date)
+				counts = append(counts, s.RatingCount)
+				require.Equal(t, tc.granularity, s.Granularity)
+				require.False(t, s.PeriodEnd.Before(s.PeriodStart))
+			}
+			require.Equal(t, tc.labels, labels)
+			require.Equal(t, tc.counts, counts)
+		})
+	}
+}
+
+func TestSQLite_CategoryScoresInTimeZone(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	insert := func(createdAt ...time.Time) {
+		var ratings []domain.Rating
+		for _, c := range createdAt {
+			ratings = append(ratings, domain.Rating{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: c})
+		}
+		_, err := repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, ratings)
+		require.NoError(t, err)
+	}
+
+	repo := repository.NewCategoryRepository(db, repository.SQLite)
+	dailyCounts := func(loc *time.Location, start, end time.Time) map[string]int {
+		scores, err := repo.GetCategoryScores(ctx, start, end, domain.TicketFilter{}, domain.GranularityDay, loc)
+		require.NoError(t, err)
+
+		counts := make(map[string]int)
+		for _, s := range scores {
+			counts[s.Date] = s.RatingCount
+		}
+		return counts
+	}
+
+	t.Run("evening ratings in APAC", func(t *testing.T) {
+		singapore, err := time.LoadLocation("Asia/Singapore")
+		require.NoError(t, err)
+
+		insert(
+			time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), // 23:00 on May 1st in Singapore
+			time.Date(2024, 5, 1, 17, 0, 0, 0, time.UTC), // 01:00 on May 2nd in Singapore
+		)
+
+		counts := dailyCounts(singapore,
+			time.Date(2024, 5, 1, 0, 0, 0, 0, singapore).UTC(),
+			time.Date(2024, 5, 3, 0, 0, 0, 0, singapore).UTC())
+
+		require.Equal(t, map[string]int{"2024-05-01": 1, "2024-05-02": 1}, counts)
+	})
+
+	t.Run("range crossing the start of DST", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		insert(
+			time.Date(2024, 3, 9, 4, 30, 0, 0, time.UTC),  // 23:30 EST on March 8th
+			time.Date(2024, 3, 11, 4, 30, 0, 0, time.UTC), // 00:30 EDT on March 11th
+		)
+
+		counts := dailyCounts(newYork,
+			time.Date(2024, 3, 4, 0, 0, 0, 0, newYork).UTC(),
+			time.Date(2024, 3, 15, 0, 0, 0, 0, newYork).UTC())
+
+		require.Equal(t, map[string]int{"2024-03-08": 1, "2024-03-11": 1}, counts)
+	})
+
+	t.Run("range crossing the end of DST", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		insert(
+			time.Date(2024, 11, 3, 4, 30, 0, 0, time.UTC), // 00:30 EDT on November 3rd
+			time.Date(2024, 11, 4, 4, 30, 0, 0, time.UTC), // 23:30 EST on November 3rd
+		)
+
+		counts := dailyCounts(newYork,
+			time.Date(2024, 10, 28, 0, 0, 0, 0, newYork).UTC(),
+			time.Date(2024, 11, 10, 0, 0, 0, 0, newYork).UTC())
+
+		require.Equal(t, map[string]int{"2024-11-03": 2}, counts)
+	})
+}
+
+func TestSQLite_DailyScoresInTimeZone(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	singapore, err := time.LoadLocation("Asia/Singapore")
+	require.NoError(t, err)
+
+	_, err = repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, []domain.Rating{
+		{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)}, // 23:00 on May 1st in Singapore
+		{TicketID: 2, RatingCategoryID: categoryID, Rating: 0, CreatedAt: time.Date(2024, 5, 1, 17, 0, 0, 0, time.UTC)}, // 01:00 on May 2nd in Singapore
+		{TicketID: 3, RatingCategoryID: categoryID, Rating: 5, CreatedAt: time.Date(2024, 5, 3, 1, 0, 0, 0, time.UTC)},
+	})
+	require.NoError(t, err)
+
+	days, err := repository.NewOverallRepository(db, repository.SQLite).GetDailyScores(ctx,
+		time.Date(2024, 5, 1, 0, 0, 0, 0, singapore).UTC(),
+		time.Date(2024, 5, 4, 0, 0, 0, 0, singapore).UTC(),
+		domain.TicketFilter{}, singapore)
+	require.NoError(t, err)
+
+	require.Len(t, days, 3)
+	require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, singapore), days[0].Day)
+	require.Equal(t, 100.0, days[0].Stats.Score())
+	require.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, singapore), days[1].Day)
+	require.Equal(t, 0.0, days[1].Stats.Score())
+	require.Equal(t, 1, days[1].Stats.RatingCount)
+	require.Equal(t, time.Date(2024, 5, 3, 0, 0, 0, 0, singapore), days[2].Day)
+}
+
+func TestSQLite_AdjacentRangesCountBoundaryOnce(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	_, err = repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, []domain.Rating{
+		{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)},
+		{TicketID: 2, RatingCategoryID: categoryID, Rating: 5, CreatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	})
+	require.NoError(t, err)
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	july := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	overall := repository.NewOverallRepository(db, repository.SQLite)
+	stats, err := overall.GetOverallScore(ctx, may, june, domain.TicketFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.RatingCount)
+
+	stats, err = overall.GetOverallScore(ctx, june, july, domain.TicketFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.RatingCount)
+
+	tickets, err := scanAllScoresByTicket(ctx, repository.NewTicketRepository(db, repository.SQLite), may, june, domain.TicketFilter{}, 0)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	require.Equal(t, 1, tickets[0].TicketID)
+
+	scores, err := repository.NewCategoryRepository(db, repository.SQLite).
+		GetCategoryScores(ctx, may, june, domain.TicketFilter{}, domain.GranularityMonth, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	require.Equal(t, 1, scores[0].RatingCount)
+	require.Equal(t, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), scores[0].PeriodEnd)
+}
+
+func TestSQLite_TicketFilters(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		`INSERT INTO tickets (id, assignee_id, team, channel, priority) VALUES (1, 10, 'billing', 'email', 'high')`,
+		`INSERT INTO tickets (id, assignee_id, team, channel, priority) VALUES (2, 11, 'billing', 'chat', 'low')`,
+		`INSERT INTO tickets (id, assignee_id, team, channel, priority) VALUES (3, 12, 'shipping', 'chat', 'high')`,
+		`INSERT INTO ticket_tags (ticket_id, tag) VALUES (1, 'vip'), (1, 'refund'), (3, 'vip')`,
+	} {
+		_, err := db.ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	createdAt := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	_, err = repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, []domain.Rating{
+		{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: createdAt},
+		{TicketID: 2, RatingCategoryID: categoryID, Rating: 3, CreatedAt: createdAt},
+		{TicketID: 3, RatingCategoryID: categoryID, Rating: 1, CreatedAt: createdAt},
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	overall := repository.NewOverallRepository(db, repository.SQLite)
+
+	for name, tc := range map[string]struct {
+		filter domain.TicketFilter
+		count  int
+	}{
+		"no filter":          {domain.TicketFilter{}, 3},
+		"team":               {domain.TicketFilter{Teams: []string{"billing"}}, 2},
+		"team and channel":   {domain.TicketFilter{Teams: []string{"billing"}, Channels: []string{"chat"}}, 1},
+		"any of assignees":   {domain.TicketFilter{AssigneeIDs: []int{10, 12}}, 2},
+		"priority and tag":   {domain.TicketFilter{Priorities: []string{"high"}, Tags: []string{"refund"}}, 1},
+		"any of tags":        {domain.TicketFilter{Tags: []string{"vip", "refund"}}, 2},
+		"no matching ticket": {domain.TicketFilter{Teams: []string{"'; DROP TABLE ratings; --"}}, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stats, err := overall.GetOverallScore(ctx, start, end, tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.count, stats.RatingCount)
+		})
+	}
+
+	filter := domain.TicketFilter{Teams: []string{"billing"}}
+
+	categories, err := repository.NewCategoryRepository(db, repository.SQLite).
+		GetCategoryScores(ctx, start, end, filter, domain.GranularityMonth, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	require.Equal(t, 2, categories[0].RatingCount)
+
+	tickets, err := scanAllScoresByTicket(ctx, repository.NewTicketRepository(db, repository.SQLite), start, end, filter, 0)
+	require.NoError(t, err)
+	require.Len(t, tickets, 2)
+	require.Equal(t, 1, tickets[0].TicketID)
+	require.Equal(t, 2, tickets[1].TicketID)
+}
+
+func TestSQLite_LeaderboardScores(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLiteDB(t)
+
+	categoryID, err := repository.NewRatingCategoryRepository(db, repository.SQLite).
+		CreateCategory(ctx, "Spelling", 1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		`INSERT INTO tickets (id, assignee_id, team, channel) VALUES (1, 10, 'billing', 'chat')`,
+		`INSERT INTO tickets (id, assignee_id, team, channel) VALUES (2, 11, 'billing', 'email')`,
+		`INSERT INTO tickets (id, assignee_id, team, channel) VALUES (3, NULL, NULL, 'chat')`,
+	} {
+		_, err := db.ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	createdAt := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	_, err = repository.NewRatingRepository(db, repository.SQLite).InsertRatings(ctx, []domain.Rating{
+		{TicketID: 1, RatingCategoryID: categoryID, Rating: 5, CreatedAt: createdAt},
+		{TicketID: 2, RatingCategoryID: categoryID, Rating: 3, CreatedAt: createdAt},
+		{TicketID: 3, RatingCategoryID: categoryID, Rating: 1, CreatedAt: createdAt},
+	})
+	require.NoError(t, err)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo := repository.NewLeaderboardRepository(db, repository.SQLite)
+
+	agents, err := repo.GetLeaderboardScores(ctx, start, end, domain.TicketFilter{}, domain.LeaderboardAgents)
+	require.NoError(t, err)
+	require.Len(t, agents, 2)
+	require.Equal(t, "10", agents[0].Key)
+	require.Equal(t, "11", agents[1].Key)
+
+	teams, err := repo.GetLeaderboardScores(ctx, start, end, domain.TicketFilter{Channels: []string{"chat"}}, domain.LeaderboardTeams)
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	require.Equal(t, "billing", teams[0].Key)
+	require.Equal(t, 1, teams[0].RatingCount)
+}
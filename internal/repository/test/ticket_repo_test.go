@@ -2,21 +2,33 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"ticket-score-engine/internal/domain"
 	"ticket-score-engine/internal/repository"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 )
 
+// scanAllScoresByTicket collects every row passed on by ScanScoresByTicket
+func scanAllScoresByTicket(ctx context.Context, repo repository.TicketRepository, start, end time.Time, filter domain.TicketFilter, afterTicketID int) ([]domain.TicketCategoryScore, error) {
+	var scores []domain.TicketCategoryScore
+	err := repo.ScanScoresByTicket(ctx, start, end, filter, afterTicketID, 0, func(score domain.TicketCategoryScore) error {
+		scores = append(scores, score)
+		return nil
+	})
+	return scores, err
+}
+
 func TestGetScoresByTicket(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
 	defer db.Close()
 
-	repo := repository.NewTicketRepository(db)
+	repo := repository.NewTicketRepository(db, repository.SQLite)
 
 	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
 	end := time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)
@@ -31,7 +43,7 @@ func TestGetScoresByTicket(t *testing.T) {
 		WithArgs(start, end).
 		WillReturnRows(rows)
 
-	result, err := repo.GetScoresByTicket(context.Background(), start, end)
+	result, err := scanAllScoresByTicket(context.Background(), repo, start, end, domain.TicketFilter{}, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
@@ -45,3 +57,83 @@ func TestGetScoresByTicket(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestScanScoresByTicket_AfterTicketID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewTicketRepository(db, repository.Postgres)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`r.created_at >= \$1 AND r.created_at < \$2\s+AND r.ticket_id > \$3\s+GROUP BY r.ticket_id, rc.name\s+ORDER BY r.ticket_id, rc.name`).
+		WithArgs(start, end, 41).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"ticket_id", "category", "weighted_score", "total_weight",
+		}).AddRow(42, "GDPR", 1.0, 1.0))
+
+	result, err := scanAllScoresByTicket(context.Background(), repo, start, end, domain.TicketFilter{}, 41)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, 42, result[0].TicketID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScanScoresByTicket_StopsOnCallbackError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewTicketRepository(db, repository.SQLite)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"ticket_id", "category", "weighted_score", "total_weight",
+		}).
+			AddRow(1, "GDPR", 1.0, 1.0).
+			AddRow(2, "GDPR", 1.0, 1.0))
+
+	stop := errors.New("stop")
+	calls := 0
+	err = repo.ScanScoresByTicket(context.Background(), start, end, domain.TicketFilter{}, 0, 0, func(domain.TicketCategoryScore) error {
+		calls++
+		return stop
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestScanScoresByTicket_Limit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewTicketRepository(db, repository.Postgres)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT DISTINCT r.ticket_id\s+FROM ratings r\s+WHERE r.created_at >= \$1 AND r.created_at < \$2\s+AND r.ticket_id > \$3\s+ORDER BY r.ticket_id\s+LIMIT \$4\s+\) page ON page.ticket_id = r.ticket_id\s+WHERE r.created_at >= \$5 AND r.created_at < \$6\s+AND r.ticket_id > \$7`).
+		WithArgs(start, end, 41, 3, start, end, 41).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"ticket_id", "category", "weighted_score", "total_weight",
+		}).AddRow(42, "GDPR", 1.0, 1.0))
+
+	var result []domain.TicketCategoryScore
+	err = repo.ScanScoresByTicket(context.Background(), start, end, domain.TicketFilter{}, 41, 3, func(score domain.TicketCategoryScore) error {
+		result = append(result, score)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
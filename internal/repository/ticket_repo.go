@@ -10,55 +10,85 @@ import (
 )
 
 type TicketRepository interface {
-	GetScoresByTicket(ctx context.Context, start, end time.Time) ([]domain.TicketCategoryScore, error)
+	// ScanScoresByTicket calls fn for every ticket matching filter and category
+	// with ratings made in [start, end), ordered by ticket ID and category name.
+	// Only tickets with an ID greater than afterTicketID are included, and at most limit
+	// tickets unless limit is 0. Rows are read one at a time,
+	// and scanning stops at the first error returned by fn, which is passed on.
+	ScanScoresByTicket(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID, limit int, fn func(domain.TicketCategoryScore) error) error
 }
 
 type ticketRepo struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect Dialect
 }
 
-func NewTicketRepository(db *sql.DB) TicketRepository {
-	return &ticketRepo{db: db}
+func NewTicketRepository(db *sql.DB, dialect Dialect) TicketRepository {
+	return &ticketRepo{db: db, dialect: dialect}
 }
 
-func (r *ticketRepo) GetScoresByTicket(ctx context.Context, start, end time.Time) ([]domain.TicketCategoryScore, error) {
+func (r *ticketRepo) ScanScoresByTicket(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID, limit int, fn func(domain.TicketCategoryScore) error) error {
+	filterJoin, filterWhere, filterArgs := ticketFilter(filter)
+	where := `
+		WHERE r.created_at >= ? AND r.created_at < ?` + filterWhere
+	whereArgs := append([]any{start, end}, filterArgs...)
+	if afterTicketID > 0 {
+		where += `
+		AND r.ticket_id > ?`
+		whereArgs = append(whereArgs, afterTicketID)
+	}
+
+	// A limit is applied to tickets rather than rows, so a page is picked
+	// first and every category of its tickets is then scored
+	var pageJoin string
+	var args []any
+	if limit > 0 {
+		pageJoin = `
+	JOIN (
+		SELECT DISTINCT r.ticket_id
+		FROM ratings r` + filterJoin + where + `
+		ORDER BY r.ticket_id
+		LIMIT ?
+	) page ON page.ticket_id = r.ticket_id`
+		args = append(append(args, whereArgs...), limit)
+	}
+	args = append(args, whereArgs...)
+
 	query := `
 		SELECT 
 			r.ticket_id,
 			rc.name AS category,
-			SUM((r.rating * 1.0 / 5.0) * rc.weight) as weighted_score,
-			SUM(rc.weight) as total_weight
-		FROM ratings r
-		JOIN rating_categories rc ON r.rating_category_id = rc.id
-		WHERE r.created_at BETWEEN ? AND ?
+			SUM((r.rating * 1.0 / 5.0) * ` + ratingWeight + `) as weighted_score,
+			SUM(` + ratingWeight + `) as total_weight` + weightedRatings + filterJoin + pageJoin + where + `
 		GROUP BY r.ticket_id, rc.name
 		ORDER BY r.ticket_id, rc.name;
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, start, end)
+	rows, err := r.db.QueryContext(ctx, r.dialect.Rebind(query), args...)
 	if err != nil {
-		return nil, fmt.Errorf("query failed: %w", err)
+		return fmt.Errorf("query failed: %w", err)
 	}
 	defer rows.Close()
 
-	var scores []domain.TicketCategoryScore
 	for rows.Next() {
 		var score domain.TicketCategoryScore
 		var weightedSum, totalWeight float64
 
 		if err := rows.Scan(&score.TicketID, &score.CategoryName, &weightedSum, &totalWeight); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+			return fmt.Errorf("failed to scan row: %w", err)
 		}
 
 		if totalWeight > 0 {
 			score.Score = (weightedSum / totalWeight) * 100
 		}
-		scores = append(scores, score)
+		if err := fn(score); err != nil {
+			return err
+		}
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %w", err)
+		return fmt.Errorf("rows error: %w", err)
 	}
 
-	return scores, nil
+	return nil
 }
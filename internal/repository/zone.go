@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// localTime returns an expression that converts the UTC timestamp expression to
+// the wall clock time of loc, together with its query arguments. The conversion
+// is exact for timestamps in [start, end), including across DST transitions.
+func localTime(dialect Dialect, expr string, loc *time.Location, start, end time.Time) (string, []any) {
+	offsets, transitions := zoneOffsets(loc, start, end)
+	if len(transitions) == 0 {
+		if offsets[0] == 0 {
+			return expr, nil
+		}
+		return dialect.ShiftSeconds(expr, strconv.Itoa(offsets[0])), nil
+	}
+
+	// Offsets are computed here, so only the transition instants are parameters
+	var b strings.Builder
+	args := make([]any, 0, len(transitions))
+	b.WriteString("CASE")
+	for i, at := range transitions {
+		b.WriteString(" WHEN " + expr + " < ? THEN " + strconv.Itoa(offsets[i]))
+		args = append(args, at)
+	}
+	b.WriteString(" ELSE " + strconv.Itoa(offsets[len(offsets)-1]) + " END")
+
+	return dialect.ShiftSeconds(expr, b.String()), args
+}
+
+// zoneOffsets returns the UTC offsets in seconds of loc between start and end,
+// and the instants at which each offset after the first takes effect
+func zoneOffsets(loc *time.Location, start, end time.Time) ([]int, []time.Time) {
+	_, offset := start.In(loc).Zone()
+	offsets := []int{offset}
+	var transitions []time.Time
+
+	// Zones never change offset twice within 12 hours, so sampling at that
+	// interval finds every transition
+	const step = 12 * time.Hour
+	for t := start; t.Before(end); t = t.Add(step) {
+		next := t.Add(step)
+		if next.After(end) {
+			next = end
+		}
+
+		_, nextOffset := next.In(loc).Zone()
+		if nextOffset == offset {
+			continue
+		}
+
+		// Narrow down to the first second with the new offset
+		lo, hi := t.Unix(), next.Unix()
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if _, o := time.Unix(mid, 0).In(loc).Zone(); o == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+
+		offset = nextOffset
+		offsets = append(offsets, offset)
+		transitions = append(transitions, time.Unix(hi, 0).UTC())
+	}
+
+	return offsets, transitions
+}
@@ -0,0 +1,138 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+const (
+	// minBaselineDays is the fewest rated days a baseline needs for a day to be judged
+	minBaselineDays = 3
+	// minSpread keeps a baseline of identical scores from flagging every small
+	// change, in percentage points
+	minSpread = 1.0
+	// madScale makes the median absolute deviation of normally distributed
+	// scores estimate their standard deviation
+	madScale = 1.4826
+)
+
+type AnomalyDetector struct {
+	repo repository.CategoryRepository
+}
+
+func NewAnomalyDetector(repo repository.CategoryRepository) *AnomalyDetector {
+	return &AnomalyDetector{repo: repo}
+}
+
+// GetAnomalies flags the daily category scores in [start, end) that deviate
+// from the same weekday of the BaselineWeeks weeks before, counted in days of
+// loc. The expected score is the median of those days and its spread their
+// scaled median absolute deviation, widened by the sampling error of the day
+// itself so that days with few ratings need a bigger deviation. Anomalies are
+// ordered by day, then category.
+func (d *AnomalyDetector) GetAnomalies(ctx context.Context, start, end time.Time, filter domain.TicketFilter, loc *time.Location, opts domain.AnomalyOptions) ([]domain.Anomaly, error) {
+	history := domain.StartOfDay(start.In(loc)).AddDate(0, 0, -7*opts.BaselineWeeks).UTC()
+	earlier, err := d.repo.GetCategoryScores(ctx, history, start, filter, domain.GranularityDay, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get baseline scores: %w", err)
+	}
+	scores, err := d.repo.GetCategoryScores(ctx, start, end, filter, domain.GranularityDay, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category scores: %w", err)
+	}
+
+	// A day can be split between both queries when start is not at midnight
+	byCategory := make(map[string]map[string]domain.ScoreStats)
+	for _, cs := range append(earlier, scores...) {
+		byDay, ok := byCategory[cs.CategoryName]
+		if !ok {
+			byDay = make(map[string]domain.ScoreStats)
+			byCategory[cs.CategoryName] = byDay
+		}
+		byDay[cs.Date] = byDay[cs.Date].Add(cs.Stats)
+	}
+
+	var anomalies []domain.Anomaly
+	for _, cs := range scores {
+		byDay := byCategory[cs.CategoryName]
+		stats := byDay[cs.Date]
+		// Ratings of a category weighted 0 give a day no score to judge
+		if stats.EffectiveSampleSize() == 0 {
+			continue
+		}
+
+		var baseline []float64
+		for week := 1; week <= opts.BaselineWeeks; week++ {
+			if stats := byDay[cs.PeriodStart.AddDate(0, 0, -7*week).Format(dayLayout)]; stats.EffectiveSampleSize() > 0 {
+				baseline = append(baseline, stats.Score())
+			}
+		}
+		if len(baseline) < minBaselineDays {
+			continue
+		}
+
+		if anomaly, ok := detectAnomaly(stats, baseline, opts.Threshold); ok {
+			anomaly.CategoryName = cs.CategoryName
+			anomaly.Day = cs.PeriodStart
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		return anomalies[i].Day.Before(anomalies[j].Day)
+	})
+	return anomalies, nil
+}
+
+// detectAnomaly judges the score of stats against the scores of its baseline
+// days and reports whether it is at least threshold robust z-scores away
+func detectAnomaly(stats domain.ScoreStats, baseline []float64, threshold float64) (domain.Anomaly, bool) {
+	expected := median(baseline)
+	deviations := make([]float64, len(baseline))
+	for i, score := range baseline {
+		deviations[i] = math.Abs(score - expected)
+	}
+
+	samplingError := 100 * math.Sqrt(stats.Variance()/stats.EffectiveSampleSize())
+	spread := math.Max(minSpread, math.Hypot(madScale*median(deviations), samplingError))
+
+	z := (stats.Score() - expected) / spread
+	if math.Abs(z) < threshold {
+		return domain.Anomaly{}, false
+	}
+
+	severity := domain.SeverityMinor
+	switch {
+	case math.Abs(z) >= 2*threshold:
+		severity = domain.SeverityCritical
+	case math.Abs(z) >= 1.5*threshold:
+		severity = domain.SeverityMajor
+	}
+
+	return domain.Anomaly{
+		Score:         stats.Score(),
+		RatingCount:   stats.RatingCount,
+		Expected:      expected,
+		ExpectedLower: math.Max(0, expected-threshold*spread),
+		ExpectedUpper: math.Min(100, expected+threshold*spread),
+		ZScore:        z,
+		Severity:      severity,
+		BaselineDays:  len(baseline),
+	}, true
+}
+
+// median returns the median of values, which it sorts
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
@@ -2,6 +2,8 @@ package scoring
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	"ticket-score-engine/internal/domain"
@@ -16,6 +18,152 @@ func NewCategoryScorer(repo repository.CategoryRepository) *CategoryScorer {
 	return &CategoryScorer{repo: repo}
 }
 
-func (s *CategoryScorer) GetCategoryScores(ctx context.Context, start, end time.Time) ([]domain.CategoryScore, error) {
-	return s.repo.GetCategoryScores(ctx, start, end)
+// GetCategoryScores aggregates the category scores of tickets matching filter
+// into buckets of the given granularity, following the calendar of loc.
+// GranularityAuto should be resolved by the caller so it can report what was
+// used. With SmoothingGlobalMean buckets shrink toward the mean of all ratings
+// returned, across categories. Rolling averages need daily buckets.
+func (s *CategoryScorer) GetCategoryScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, granularity domain.Granularity, loc *time.Location, opts domain.ScoreOptions) ([]domain.CategoryScore, error) {
+	if opts.RollingDays > 0 && granularity != domain.GranularityDay {
+		return nil, fmt.Errorf("rolling averages need daily buckets, not %s", granularity)
+	}
+
+	scores, err := s.repo.GetCategoryScores(ctx, start, end, filter, granularity, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.RollingDays > 0 {
+		if err := s.setRollingScores(ctx, scores, start, filter, loc, opts.RollingDays); err != nil {
+			return nil, err
+		}
+	}
+	if opts.DecayHalfLifeDays > 0 {
+		setDecayedScores(scores, granularity, opts.DecayHalfLifeDays)
+	}
+
+	smoothing := opts.Smoothing
+	prior := smoothing.Prior
+	if smoothing.Mode == domain.SmoothingGlobalMean {
+		var all domain.ScoreStats
+		for _, cs := range scores {
+			all = all.Add(cs.Stats)
+		}
+		prior = all.Score()
+	}
+
+	for i := range scores {
+		scores[i].SmoothedScore = Smooth(scores[i].Stats, smoothing, prior)
+		scores[i].EffectiveSampleSize = scores[i].Stats.EffectiveSampleSize()
+		scores[i].Interval = ConfidenceInterval(scores[i].Stats, opts.ConfidenceLevel)
+	}
+
+	return scores, nil
+}
+
+// setRollingScores sets the moving average of every daily score, looking back
+// before start for the first days of the range
+func (s *CategoryScorer) setRollingScores(ctx context.Context, scores []domain.CategoryScore, start time.Time, filter domain.TicketFilter, loc *time.Location, days int) error {
+	history := domain.StartOfDay(start.In(loc)).AddDate(0, 0, 1-days).UTC()
+	var earlier []domain.CategoryScore
+	if history.Before(start) {
+		var err error
+		if earlier, err = s.repo.GetCategoryScores(ctx, history, start, filter, domain.GranularityDay, loc); err != nil {
+			return fmt.Errorf("failed to get scores before the range: %w", err)
+		}
+	}
+
+	// A day can be split between both queries when start is not at midnight
+	byCategory := make(map[string]map[string]domain.ScoreStats)
+	for _, cs := range append(earlier, scores...) {
+		byDay, ok := byCategory[cs.CategoryName]
+		if !ok {
+			byDay = make(map[string]domain.ScoreStats)
+			byCategory[cs.CategoryName] = byDay
+		}
+		byDay[cs.Date] = byDay[cs.Date].Add(cs.Stats)
+	}
+
+	for i := range scores {
+		rolling := rollingStats(byCategory[scores[i].CategoryName], scores[i].PeriodStart, days)
+		scores[i].RollingScore = rolling.Score()
+		scores[i].RollingRatingCount = rolling.RatingCount
+	}
+	return nil
+}
+
+// setDecayedScores sets the exponentially decayed score of every bucket from
+// the earlier buckets of its category, aged by the days between bucket starts
+func setDecayedScores(scores []domain.CategoryScore, granularity domain.Granularity, halfLifeDays float64) {
+	byCategory := make(map[string][]domain.DailyScoreStats)
+	for _, cs := range scores {
+		byCategory[cs.CategoryName] = append(byCategory[cs.CategoryName], domain.DailyScoreStats{
+			Day:   granularity.BucketStart(cs.PeriodStart),
+			Stats: cs.Stats,
+		})
+	}
+
+	for i := range scores {
+		at := granularity.BucketStart(scores[i].PeriodStart)
+		scores[i].DecayedScore = decayedScore(byCategory[scores[i].CategoryName], at, halfLifeDays)
+	}
+}
+
+// GetCategoryPeriodComparison compares the score of every category rated in
+// either period, both restricted to the tickets matching filter. The biggest
+// regressions come first; categories rated in only one of the periods follow,
+// ordered by name.
+func (s *CategoryScorer) GetCategoryPeriodComparison(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, filter domain.TicketFilter, opts domain.ComparisonOptions) ([]domain.CategoryComparison, error) {
+	current, err := s.repo.GetCategoryTotals(ctx, currentStart, currentEnd, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current period category scores: %w", err)
+	}
+
+	previous, err := s.repo.GetCategoryTotals(ctx, previousStart, previousEnd, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get previous period category scores: %w", err)
+	}
+
+	type periods struct{ current, previous domain.ScoreStats }
+	byName := make(map[string]*periods)
+	var names []string
+	stats := func(name string) *periods {
+		p, ok := byName[name]
+		if !ok {
+			p = &periods{}
+			byName[name] = p
+			names = append(names, name)
+		}
+		return p
+	}
+	for _, cs := range current {
+		stats(cs.CategoryName).current = cs.Stats
+	}
+	for _, cs := range previous {
+		stats(cs.CategoryName).previous = cs.Stats
+	}
+
+	comparisons := make([]domain.CategoryComparison, 0, len(names))
+	for _, name := range names {
+		p := byName[name]
+		comparisons = append(comparisons, domain.CategoryComparison{
+			CategoryName:           name,
+			PeriodComparisonResult: comparePeriods(p.current, p.previous, opts),
+		})
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		a, b := comparisons[i], comparisons[j]
+		aBoth := a.CurrentCount > 0 && a.PreviousCount > 0
+		bBoth := b.CurrentCount > 0 && b.PreviousCount > 0
+		if aBoth != bBoth {
+			return aBoth
+		}
+		if aBoth && a.AbsoluteChange != b.AbsoluteChange {
+			return a.AbsoluteChange < b.AbsoluteChange
+		}
+		return a.CategoryName < b.CategoryName
+	})
+
+	return comparisons, nil
 }
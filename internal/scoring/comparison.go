@@ -0,0 +1,35 @@
+package scoring
+
+import "ticket-score-engine/internal/domain"
+
+// comparePeriods compares the scores of the ratings of two periods
+func comparePeriods(current, previous domain.ScoreStats, opts domain.ComparisonOptions) domain.PeriodComparisonResult {
+	currentScore, previousScore := current.Score(), previous.Score()
+
+	var change float64
+	baseline := domain.BaselineOK
+	switch {
+	case previous.RatingCount == 0:
+		baseline = domain.BaselineNoRatings
+	case previousScore == 0:
+		baseline = domain.BaselineZeroScore
+	default:
+		change = ((currentScore - previousScore) / previousScore) * 100
+	}
+
+	pValue := TwoSampleTest(current, previous)
+
+	return domain.PeriodComparisonResult{
+		PercentageChange: change,
+		AbsoluteChange:   currentScore - previousScore,
+		CurrentScore:     currentScore,
+		PreviousScore:    previousScore,
+		CurrentCount:     current.RatingCount,
+		PreviousCount:    previous.RatingCount,
+		CurrentInterval:  ConfidenceInterval(current, opts.ConfidenceLevel),
+		PreviousInterval: ConfidenceInterval(previous, opts.ConfidenceLevel),
+		Baseline:         baseline,
+		PValue:           pValue,
+		IsSignificant:    pValue < opts.Alpha,
+	}
+}
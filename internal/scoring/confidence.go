@@ -0,0 +1,39 @@
+package scoring
+
+import (
+	"math"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// ConfidenceInterval returns the interval the true score of stats lies in at
+// the given confidence level. It is a Wilson score interval over the effective
+// sample size, with the weighted variance of the ratings in place of the
+// binomial p(1-p). For ratings of only 0 and 5 stars this is exactly the Wilson
+// interval; unlike a normal interval it stays within 0-100% and does not
+// collapse to a point when a handful of ratings happen to agree. Without any
+// ratings the interval is the whole scale.
+func ConfidenceInterval(stats domain.ScoreStats, level float64) domain.ConfidenceInterval {
+	n := stats.EffectiveSampleSize()
+	if n == 0 {
+		return domain.ConfidenceInterval{Lower: 0, Upper: 100, Level: level}
+	}
+
+	z := zScore(level)
+	p := stats.Score() / 100
+	denominator := 1 + z*z/n
+	center := (p + z*z/(2*n)) / denominator
+	halfWidth := z / denominator * math.Sqrt(stats.Variance()/n+z*z/(4*n*n))
+
+	return domain.ConfidenceInterval{
+		Lower: math.Max(0, center-halfWidth) * 100,
+		Upper: math.Min(1, center+halfWidth) * 100,
+		Level: level,
+	}
+}
+
+// zScore returns the standard normal quantile of a two-sided confidence level,
+// 1.96 for 0.95
+func zScore(level float64) float64 {
+	return math.Sqrt2 * math.Erfinv(level)
+}
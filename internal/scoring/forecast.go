@@ -0,0 +1,181 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+// minForecastWeeks is the fewest rated weeks a series needs to be forecast
+const minForecastWeeks = 3
+
+// holtGridSteps is the resolution of the search for the smoothing parameters
+const holtGridSteps = 20
+
+type ForecastScorer struct {
+	trends *TrendScorer
+}
+
+func NewForecastScorer(repo repository.CategoryRepository) *ForecastScorer {
+	return &ForecastScorer{trends: NewTrendScorer(repo)}
+}
+
+// GetScoreForecast projects the weekly overall score, and optionally that of
+// every category, for opts.Weeks weeks starting with the week of now. Each
+// series is fitted with Holt's linear trend method on the weekly trend of the
+// opts.HistoryWeeks complete weeks before, in the calendar of loc. Weeks
+// without ratings are passed over by the fit. Categories with fewer than 3
+// rated weeks are left out; the overall score needs 3 to be forecast at all.
+func (s *ForecastScorer) GetScoreForecast(ctx context.Context, now time.Time, filter domain.TicketFilter, loc *time.Location, opts domain.ForecastOptions) (*domain.ScoreForecast, error) {
+	week := domain.GranularityWeek
+	current := week.BucketStart(now.In(loc))
+	start := current.AddDate(0, 0, -7*opts.HistoryWeeks)
+
+	trend, err := s.trends.GetScoreTrend(ctx, start.UTC(), current.UTC(), filter, week, loc, opts.IncludeCategories, opts.ConfidenceLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := make([]domain.ForecastPoint, opts.Weeks)
+	for i := range upcoming {
+		bucket := current.AddDate(0, 0, 7*i)
+		upcoming[i] = domain.ForecastPoint{
+			Date:        week.Label(bucket),
+			PeriodStart: bucket,
+			PeriodEnd:   week.BucketEnd(bucket).AddDate(0, 0, -1),
+		}
+	}
+
+	overall, ok := forecastSeries(trend.Overall, upcoming, opts.ConfidenceLevel)
+	if !ok {
+		return nil, fmt.Errorf("%w: at least %d are needed", domain.ErrNotEnoughHistory, minForecastWeeks)
+	}
+
+	forecast := &domain.ScoreForecast{Overall: overall}
+	for _, c := range trend.Categories {
+		if points, ok := forecastSeries(c.Points, upcoming, opts.ConfidenceLevel); ok {
+			forecast.Categories = append(forecast.Categories, domain.CategoryForecast{
+				CategoryName: c.CategoryName,
+				Points:       points,
+			})
+		}
+	}
+
+	return forecast, nil
+}
+
+// forecastSeries fills in the upcoming points from the history, reporting
+// false when too few of its weeks are rated. Weeks rated only in categories
+// weighted 0 have no score and count as unrated.
+func forecastSeries(history []domain.TrendPoint, upcoming []domain.ForecastPoint, level float64) ([]domain.ForecastPoint, bool) {
+	values := make([]float64, len(history))
+	rated := 0
+	for i, p := range history {
+		values[i] = math.NaN()
+		if p.Stats.EffectiveSampleSize() > 0 {
+			values[i] = p.Score
+			rated++
+		}
+	}
+	if rated < minForecastWeeks {
+		return nil, false
+	}
+
+	fit := fitHolt(values)
+	z := zScore(level)
+	points := make([]domain.ForecastPoint, len(upcoming))
+	for i, p := range upcoming {
+		score, sd := fit.forecast(i + 1)
+		p.Score = clampScore(score)
+		p.Interval = domain.ConfidenceInterval{
+			Lower: clampScore(score - z*sd),
+			Upper: clampScore(score + z*sd),
+			Level: level,
+		}
+		points[i] = p
+	}
+	return points, true
+}
+
+// holtFit is Holt's linear trend method in error correction form, with the
+// level and trend after the last value of the series
+type holtFit struct {
+	alpha, beta  float64
+	level, trend float64
+	sse          float64 // Sum of the squared one-step errors
+	errors       int
+}
+
+// fitHolt picks the smoothing parameters 0 < alpha <= 1 and 0 <= beta <= alpha
+// with the smallest one-step errors over values, in which NaN marks a missing
+// value. The series needs at least three values.
+func fitHolt(values []float64) holtFit {
+	best := runHolt(values, 1.0/holtGridSteps, 0)
+	for i := 1; i <= holtGridSteps; i++ {
+		for j := 0; j <= i; j++ {
+			if fit := runHolt(values, float64(i)/holtGridSteps, float64(j)/holtGridSteps); fit.sse < best.sse {
+				best = fit
+			}
+		}
+	}
+	return best
+}
+
+// runHolt starts the level at the first value and the trend at the slope to
+// the second, and then updates both with every later value. Missing values
+// carry the level forward along the trend.
+func runHolt(values []float64, alpha, beta float64) holtFit {
+	fit := holtFit{alpha: alpha, beta: beta}
+
+	first, second := -1, -1
+	for t, y := range values {
+		if math.IsNaN(y) {
+			continue
+		}
+		if first < 0 {
+			first = t
+		} else {
+			second = t
+			break
+		}
+	}
+	fit.level = values[first]
+	fit.trend = (values[second] - values[first]) / float64(second-first)
+
+	for t := first + 1; t < len(values); t++ {
+		predicted := fit.level + fit.trend
+		if math.IsNaN(values[t]) {
+			fit.level = predicted
+			continue
+		}
+		e := values[t] - predicted
+		fit.level = predicted + alpha*e
+		fit.trend += beta * e
+		// The start of the trend makes the second value fit exactly
+		if t > second {
+			fit.sse += e * e
+			fit.errors++
+		}
+	}
+	return fit
+}
+
+// forecast returns the score h weeks after the last one of the series and the
+// standard deviation of its prediction error
+func (f holtFit) forecast(h int) (float64, float64) {
+	variance := 0.0
+	if f.errors > 0 {
+		variance = f.sse / float64(f.errors)
+	}
+	a, b, n := f.alpha, f.beta, float64(h)
+	variance *= 1 + (n-1)*(a*a+a*b*n+b*b*n*(2*n-1)/6)
+	return f.level + n*f.trend, math.Sqrt(variance)
+}
+
+func clampScore(score float64) float64 {
+	return math.Max(0, math.Min(100, score))
+}
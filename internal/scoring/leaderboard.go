@@ -0,0 +1,106 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+type LeaderboardScorer struct {
+	repo repository.LeaderboardRepository
+}
+
+func NewLeaderboardScorer(repo repository.LeaderboardRepository) *LeaderboardScorer {
+	return &LeaderboardScorer{repo: repo}
+}
+
+// GetLeaderboard ranks agents or teams by their weighted score over the
+// period of q, and compares each rank with the previous period
+func (s *LeaderboardScorer) GetLeaderboard(ctx context.Context, q domain.LeaderboardQuery) ([]domain.LeaderboardEntry, error) {
+	current, err := s.rank(ctx, q, q.Start, q.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank current period: %w", err)
+	}
+	previous, err := s.rank(ctx, q, q.PreviousStart, q.PreviousEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank previous period: %w", err)
+	}
+
+	previousRanks := make(map[string]int, len(previous))
+	for _, e := range previous {
+		previousRanks[e.Key] = e.Rank
+	}
+	for i := range current {
+		if rank, ok := previousRanks[current[i].Key]; ok {
+			current[i].PreviousRank = rank
+			current[i].RankChange = rank - current[i].Rank
+		}
+	}
+
+	if q.WorstFirst {
+		slices.Reverse(current)
+	}
+	if q.Limit > 0 && len(current) > q.Limit {
+		current = current[:q.Limit]
+	}
+
+	return current, nil
+}
+
+// rank returns the agents or teams with at least q.MinRatingCount ratings in
+// [start, end), best first
+func (s *LeaderboardScorer) rank(ctx context.Context, q domain.LeaderboardQuery, start, end time.Time) ([]domain.LeaderboardEntry, error) {
+	rows, err := s.repo.GetLeaderboardScores(ctx, start, end, q.Filter, q.Dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows are ordered by key, so the categories of an entry are consecutive
+	var entries []domain.LeaderboardEntry
+	var weightedSums, totalWeights []float64
+	for _, row := range rows {
+		if n := len(entries); n == 0 || entries[n-1].Key != row.Key {
+			entries = append(entries, domain.LeaderboardEntry{Key: row.Key})
+			weightedSums = append(weightedSums, 0)
+			totalWeights = append(totalWeights, 0)
+		}
+		n := len(entries) - 1
+
+		category := domain.LeaderboardCategory{CategoryName: row.CategoryName, RatingCount: row.RatingCount}
+		if row.TotalWeight > 0 {
+			category.Score = (row.WeightedSum / row.TotalWeight) * 100
+		}
+		entries[n].Categories = append(entries[n].Categories, category)
+		entries[n].RatingCount += row.RatingCount
+		weightedSums[n] += row.WeightedSum
+		totalWeights[n] += row.TotalWeight
+	}
+
+	ranked := entries[:0]
+	for i, e := range entries {
+		if e.RatingCount < q.MinRatingCount {
+			continue
+		}
+		if totalWeights[i] > 0 {
+			e.Score = (weightedSums[i] / totalWeights[i]) * 100
+		}
+		ranked = append(ranked, e)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+		if i > 0 && ranked[i].Score == ranked[i-1].Score {
+			ranked[i].Rank = ranked[i-1].Rank
+		}
+	}
+
+	return ranked, nil
+}
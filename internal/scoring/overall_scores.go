@@ -17,63 +17,93 @@ func NewOverallScorer(repo repository.OverallRepository) *OverallScorer {
 	return &OverallScorer{repo: repo}
 }
 
-func (s *OverallScorer) GetOverallScore(ctx context.Context, start, end time.Time) (*domain.OverallScoreResult, error) {
-	score, count, err := s.repo.GetOverallScore(ctx, start, end)
+// GetOverallScore returns the weighted score of the ratings of tickets matching
+// filter. With SmoothingGlobalMean it shrinks toward the score of all tickets
+// in the range, ignoring the filter. Rolling and decayed scores count days in
+// loc.
+func (s *OverallScorer) GetOverallScore(ctx context.Context, start, end time.Time, filter domain.TicketFilter, loc *time.Location, opts domain.ScoreOptions) (*domain.OverallScoreResult, error) {
+	stats, err := s.repo.GetOverallScore(ctx, start, end, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.OverallScoreResult{
-		Score:       score,
-		RatingCount: count,
-	}, nil
+	smoothing := opts.Smoothing
+	prior := smoothing.Prior
+	if smoothing.Mode == domain.SmoothingGlobalMean {
+		global := stats
+		if !filter.IsEmpty() {
+			if global, err = s.repo.GetOverallScore(ctx, start, end, domain.TicketFilter{}); err != nil {
+				return nil, fmt.Errorf("failed to get global mean: %w", err)
+			}
+		}
+		prior = global.Score()
+	}
+
+	result := &domain.OverallScoreResult{
+		Score:               stats.Score(),
+		RatingCount:         stats.RatingCount,
+		Stats:               stats,
+		SmoothedScore:       Smooth(stats, smoothing, prior),
+		EffectiveSampleSize: stats.EffectiveSampleSize(),
+		Interval:            ConfidenceInterval(stats, opts.ConfidenceLevel),
+	}
+
+	lastDay := domain.StartOfDay(end.In(loc).Add(-time.Nanosecond))
+	if opts.RollingDays > 0 {
+		rolling, err := s.repo.GetOverallScore(ctx, lastDay.AddDate(0, 0, 1-opts.RollingDays).UTC(), end, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rolling score: %w", err)
+		}
+		result.RollingScore = rolling.Score()
+		result.RollingRatingCount = rolling.RatingCount
+	}
+	if opts.DecayHalfLifeDays > 0 {
+		days, err := s.repo.GetDailyScores(ctx, start, end, filter, loc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily scores: %w", err)
+		}
+		result.DecayedScore = decayedScore(days, lastDay, opts.DecayHalfLifeDays)
+	}
+
+	return result, nil
 }
 
-func (s *OverallScorer) GetPeriodComparison(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time) (*domain.PeriodComparisonResult, error) {
-	current, err := s.GetOverallScore(ctx, currentStart, currentEnd)
+// GetPeriodComparison compares the overall scores of two periods, both restricted
+// to the tickets matching filter. The percentage change is only given when the
+// previous period has a score above 0, see Baseline.
+func (s *OverallScorer) GetPeriodComparison(ctx context.Context, currentStart, currentEnd, previousStart, previousEnd time.Time, filter domain.TicketFilter, opts domain.ComparisonOptions) (*domain.PeriodComparisonResult, error) {
+	current, err := s.repo.GetOverallScore(ctx, currentStart, currentEnd, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current period score: %w", err)
 	}
 
-	previous, err := s.GetOverallScore(ctx, previousStart, previousEnd)
+	previous, err := s.repo.GetOverallScore(ctx, previousStart, previousEnd, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get previous period score: %w", err)
 	}
 
-	var change float64
-	if previous.Score != 0 {
-		change = ((current.Score - previous.Score) / previous.Score) * 100
-	}
-
-	return &domain.PeriodComparisonResult{
-		PercentageChange: change,
-		CurrentScore:     current.Score,
-		PreviousScore:    previous.Score,
-		CurrentCount:     current.RatingCount,
-		PreviousCount:    previous.RatingCount,
-	}, nil
+	result := comparePeriods(current, previous, opts)
+	return &result, nil
 }
 
-// function to calculate time ranges for common comparisons
+// GetComparisonPeriods resolves "week" (the last 7 days) and "month" (month to
+// date) in UTC against the system clock. Ranges are half-open, so each end is
+// the start of the following period. Use PeriodResolver for the other presets,
+// other time zones or a different clock.
 func GetComparisonPeriods(period string) (time.Time, time.Time, time.Time, time.Time, error) {
-	now := time.Now()
-	var currentStart, currentEnd, previousStart, previousEnd time.Time
-
+	var preset domain.PeriodPreset
 	switch period {
 	case "week":
-		currentStart = now.AddDate(0, 0, -7)
-		currentEnd = now
-		previousStart = now.AddDate(0, 0, -14)
-		previousEnd = now.AddDate(0, 0, -7)
+		preset = domain.PresetLast7Days
 	case "month":
-		currentStart = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
-		currentEnd = now
-		prevMonth := now.AddDate(0, -1, 0)
-		previousStart = time.Date(prevMonth.Year(), prevMonth.Month(), 1, 0, 0, 0, 0, time.UTC)
-		previousEnd = time.Date(prevMonth.Year(), prevMonth.Month()+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+		preset = domain.PresetMonthToDate
 	default:
 		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid period: %s", period)
 	}
 
-	return currentStart, currentEnd, previousStart, previousEnd, nil
+	p, err := NewPeriodResolver(time.Now).Resolve(preset, 0, domain.CompareToPreceding, time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+	}
+	return p.CurrentStart, p.CurrentEnd, p.PreviousStart, p.PreviousEnd, nil
 }
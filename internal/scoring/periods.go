@@ -0,0 +1,87 @@
+package scoring
+
+import (
+	"fmt"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// PeriodResolver turns period presets into date ranges relative to its clock
+type PeriodResolver struct {
+	now func() time.Time
+}
+
+func NewPeriodResolver(now func() time.Time) *PeriodResolver {
+	return &PeriodResolver{now: now}
+}
+
+// Resolve returns the current period of preset, which runs from the start of
+// a day in loc up to now, and the previous period to compare it with.
+// rollingDays is the length of PresetRollingDays. The preceding period of a
+// to-date preset covers the same part of the week, month, quarter or year
+// before, so a month-to-date on the 10th is compared with the first 9 days and
+// a bit of the previous month.
+func (r *PeriodResolver) Resolve(preset domain.PeriodPreset, rollingDays int, compareTo domain.CompareTo, loc *time.Location) (domain.ComparisonPeriods, error) {
+	now := r.now().In(loc)
+	today := domain.StartOfDay(now)
+
+	var start time.Time
+	var previous func(time.Time) time.Time
+	switch preset {
+	case domain.PresetLast7Days:
+		rollingDays = 7
+		fallthrough
+	case domain.PresetRollingDays:
+		if rollingDays <= 0 {
+			return domain.ComparisonPeriods{}, fmt.Errorf("invalid number of rolling days: %d", rollingDays)
+		}
+		start = today.AddDate(0, 0, 1-rollingDays)
+		previous = func(t time.Time) time.Time { return t.AddDate(0, 0, -rollingDays) }
+	case domain.PresetWeekToDate:
+		// Weeks start on Monday, as ISO weeks do
+		start = today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+		previous = func(t time.Time) time.Time { return t.AddDate(0, 0, -7) }
+	case domain.PresetMonthToDate:
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+		previous = func(t time.Time) time.Time { return addMonths(t, -1) }
+	case domain.PresetQuarterToDate:
+		start = time.Date(now.Year(), now.Month()-(now.Month()-1)%3, 1, 0, 0, 0, 0, loc)
+		previous = func(t time.Time) time.Time { return addMonths(t, -3) }
+	case domain.PresetYearToDate:
+		start = time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, loc)
+		previous = func(t time.Time) time.Time { return addMonths(t, -12) }
+	default:
+		return domain.ComparisonPeriods{}, fmt.Errorf("invalid period preset: %d", preset)
+	}
+
+	switch compareTo {
+	case domain.CompareToPreceding:
+	case domain.CompareToSamePeriodLastYear:
+		previous = func(t time.Time) time.Time { return addMonths(t, -12) }
+	default:
+		return domain.ComparisonPeriods{}, fmt.Errorf("invalid comparison: %d", compareTo)
+	}
+
+	return domain.ComparisonPeriods{
+		CurrentStart:  start.UTC(),
+		CurrentEnd:    now.UTC(),
+		PreviousStart: previous(start).UTC(),
+		PreviousEnd:   previous(now).UTC(),
+	}, nil
+}
+
+// SamePeriodLastYear returns the range [start, end) one year earlier, with
+// the dates taken in loc
+func SamePeriodLastYear(start, end time.Time, loc *time.Location) (time.Time, time.Time) {
+	return addMonths(start.In(loc), -12).UTC(), addMonths(end.In(loc), -12).UTC()
+}
+
+// addMonths moves t by n months, keeping the time of day. Days that do not
+// exist in the target month become its last day, so March 31st minus one
+// month is the end of February rather than early March.
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
@@ -0,0 +1,40 @@
+package scoring
+
+import (
+	"math"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+)
+
+const dayLayout = "2006-01-02"
+
+// rollingStats returns the combined stats of the given number of days ending
+// with day, where byDay holds the stats of every day with ratings
+func rollingStats(byDay map[string]domain.ScoreStats, day time.Time, days int) domain.ScoreStats {
+	var total domain.ScoreStats
+	for i := 0; i < days; i++ {
+		total = total.Add(byDay[day.AddDate(0, 0, -i).Format(dayLayout)])
+	}
+	return total
+}
+
+// decayedScore returns the score of the stats up to at, with each weighted by
+// 2^(-age/halfLifeDays) for its age in days. Later stats are ignored.
+func decayedScore(stats []domain.DailyScoreStats, at time.Time, halfLifeDays float64) float64 {
+	var weightedSum, totalWeight float64
+	for _, s := range stats {
+		// Local days are 23 or 25 hours long across DST changes
+		age := math.Round(at.Sub(s.Day).Hours() / 24)
+		if age < 0 {
+			continue
+		}
+		decay := math.Exp2(-age / halfLifeDays)
+		weightedSum += decay * s.Stats.WeightedSum
+		totalWeight += decay * s.Stats.TotalWeight
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return weightedSum / totalWeight * 100
+}
@@ -0,0 +1,31 @@
+package scoring
+
+import (
+	"math"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// TwoSampleTest returns the two-sided p-value of Welch's test that the scores
+// of a and b differ. Each sample is sized by its effective sample size and
+// uses its unbiased weighted variance. Samples worth fewer than two ratings
+// give no evidence either way, so the p-value is 1.
+func TwoSampleTest(a, b domain.ScoreStats) float64 {
+	na, nb := a.EffectiveSampleSize(), b.EffectiveSampleSize()
+	if na < 2 || nb < 2 {
+		return 1
+	}
+
+	diff := (a.Score() - b.Score()) / 100
+	standardError := math.Sqrt(a.Variance()/(na-1) + b.Variance()/(nb-1))
+	if standardError == 0 {
+		// Neither period varies, so any difference is certain
+		if diff == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	z := diff / standardError
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
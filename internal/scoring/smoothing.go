@@ -0,0 +1,15 @@
+package scoring
+
+import "ticket-score-engine/internal/domain"
+
+// Smooth shrinks the score of stats toward prior, a percentage. A score based
+// on n effective ratings becomes (n·score + strength·prior) / (n + strength),
+// so buckets with few ratings move toward the prior while busy ones barely
+// change.
+func Smooth(stats domain.ScoreStats, smoothing domain.Smoothing, prior float64) float64 {
+	n := stats.EffectiveSampleSize()
+	if smoothing.Mode == domain.SmoothingNone || n+smoothing.Strength == 0 {
+		return stats.Score()
+	}
+	return (n*stats.Score() + smoothing.Strength*prior) / (n + smoothing.Strength)
+}
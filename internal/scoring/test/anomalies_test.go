@@ -0,0 +1,154 @@
+package scoring_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+var anomalyOptions = domain.AnomalyOptions{Threshold: 3.5, BaselineWeeks: 4}
+
+func TestGetAnomalies_FlagsDropAgainstSameWeekday(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	detector := scoring.NewAnomalyDetector(mockRepo)
+
+	// Monday and Tuesday, June 2nd and 3rd
+	start, end := day("2025-06-02"), day("2025-06-04")
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-05"), start, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-05-05", 10, 90),
+			dailyScore("GDPR", "2025-05-06", 10, 50),
+			dailyScore("GDPR", "2025-05-12", 10, 92),
+			dailyScore("GDPR", "2025-05-13", 10, 52),
+			dailyScore("GDPR", "2025-05-19", 10, 88),
+			dailyScore("GDPR", "2025-05-20", 10, 48),
+			dailyScore("GDPR", "2025-05-26", 10, 91),
+			dailyScore("GDPR", "2025-05-27", 10, 50),
+			// Too few days to judge
+			dailyScore("Grammar", "2025-05-19", 10, 90),
+			dailyScore("Grammar", "2025-05-26", 10, 90),
+		}, nil)
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-06-02", 20, 40),
+			// Usual for a Tuesday
+			dailyScore("GDPR", "2025-06-03", 10, 50),
+			dailyScore("Grammar", "2025-06-02", 10, 10),
+		}, nil)
+
+	anomalies, err := detector.GetAnomalies(context.Background(), start, end, domain.TicketFilter{}, time.UTC, anomalyOptions)
+
+	assert.NoError(t, err)
+	assert.Len(t, anomalies, 1)
+
+	a := anomalies[0]
+	assert.Equal(t, "GDPR", a.CategoryName)
+	assert.Equal(t, start, a.Day)
+	assert.Equal(t, 40.0, a.Score)
+	assert.Equal(t, 20, a.RatingCount)
+	assert.InDelta(t, 90.5, a.Expected, 0.0001)
+	// The spread combines the scaled MAD of 1 point with the sampling error
+	// of 20 ratings at 40%
+	assert.InDelta(t, -4.568, a.ZScore, 0.001)
+	assert.InDelta(t, 51.81, a.ExpectedLower, 0.01)
+	assert.Equal(t, 100.0, a.ExpectedUpper)
+	assert.Equal(t, domain.SeverityMinor, a.Severity)
+	assert.Equal(t, 4, a.BaselineDays)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAnomalies_Severity(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	detector := scoring.NewAnomalyDetector(mockRepo)
+
+	start, end := day("2025-06-02"), day("2025-06-03")
+
+	// Identical baseline days leave only the minimum spread of 1 point
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-05"), start, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-05-12", 10, 100),
+			dailyScore("GDPR", "2025-05-19", 10, 100),
+			dailyScore("GDPR", "2025-05-26", 10, 100),
+			dailyScore("Grammar", "2025-05-12", 10, 0),
+			dailyScore("Grammar", "2025-05-19", 10, 0),
+			dailyScore("Grammar", "2025-05-26", 10, 0),
+		}, nil)
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-06-02", 10, 0),
+			dailyScore("Grammar", "2025-06-02", 10, 0),
+		}, nil)
+
+	anomalies, err := detector.GetAnomalies(context.Background(), start, end, domain.TicketFilter{}, time.UTC, anomalyOptions)
+
+	assert.NoError(t, err)
+	assert.Len(t, anomalies, 1)
+	assert.Equal(t, "GDPR", anomalies[0].CategoryName)
+	assert.Equal(t, -100.0, anomalies[0].ZScore)
+	assert.Equal(t, domain.SeverityCritical, anomalies[0].Severity)
+	assert.Equal(t, 3, anomalies[0].BaselineDays)
+	assert.InDelta(t, 96.5, anomalies[0].ExpectedLower, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
+// zeroWeightScore is a day of ratings in a category weighted 0
+func zeroWeightScore(name, date string, count int) domain.CategoryScore {
+	cs := dailyScore(name, date, count, 0)
+	cs.Stats = domain.ScoreStats{RatingCount: count}
+	return cs
+}
+
+func TestGetAnomalies_SkipsZeroWeightDays(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	detector := scoring.NewAnomalyDetector(mockRepo)
+
+	start, end := day("2025-06-02"), day("2025-06-03")
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-05"), start, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-05-05", 10, 90),
+			dailyScore("GDPR", "2025-05-12", 10, 90),
+			dailyScore("GDPR", "2025-05-19", 10, 90),
+			dailyScore("Tone", "2025-05-05", 10, 90),
+			dailyScore("Tone", "2025-05-12", 10, 90),
+			// Too few weighted days to judge
+			zeroWeightScore("Tone", "2025-05-19", 10),
+			zeroWeightScore("Tone", "2025-05-26", 10),
+		}, nil)
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			zeroWeightScore("GDPR", "2025-06-02", 10),
+			dailyScore("Tone", "2025-06-02", 10, 10),
+		}, nil)
+
+	anomalies, err := detector.GetAnomalies(context.Background(), start, end, domain.TicketFilter{}, time.UTC, anomalyOptions)
+
+	assert.NoError(t, err)
+	assert.Empty(t, anomalies)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetAnomalies_Error(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	detector := scoring.NewAnomalyDetector(mockRepo)
+
+	start, end := day("2025-06-02"), day("2025-06-03")
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-05"), start, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{}, errors.New("db error"))
+
+	anomalies, err := detector.GetAnomalies(context.Background(), start, end, domain.TicketFilter{}, time.UTC, anomalyOptions)
+
+	assert.Error(t, err)
+	assert.Nil(t, anomalies)
+}
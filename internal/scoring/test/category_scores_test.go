@@ -2,6 +2,7 @@ package scoring_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -16,8 +17,13 @@ type mockCategoryRepo struct {
 	mock.Mock
 }
 
-func (m *mockCategoryRepo) GetCategoryScores(ctx context.Context, start, end time.Time) ([]domain.CategoryScore, error) {
-	args := m.Called(ctx, start, end)
+func (m *mockCategoryRepo) GetCategoryScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, granularity domain.Granularity, loc *time.Location) ([]domain.CategoryScore, error) {
+	args := m.Called(ctx, start, end, filter, granularity, loc)
+	return args.Get(0).([]domain.CategoryScore), args.Error(1)
+}
+
+func (m *mockCategoryRepo) GetCategoryTotals(ctx context.Context, start, end time.Time, filter domain.TicketFilter) ([]domain.CategoryScore, error) {
+	args := m.Called(ctx, start, end, filter)
 	return args.Get(0).([]domain.CategoryScore), args.Error(1)
 }
 
@@ -28,17 +34,129 @@ func TestGetCategoryScores(t *testing.T) {
 	start := time.Now().AddDate(0, 0, -7)
 	end := time.Now()
 
-	expected := []domain.CategoryScore{
-		{CategoryName: "GDPR", Date: "2025-05-01", RatingCount: 10, Score: 87.5},
-		{CategoryName: "Grammer", Date: "2025-05-01", RatingCount: 12, Score: 90.0},
+	scores := func() []domain.CategoryScore {
+		return []domain.CategoryScore{
+			{CategoryName: "GDPR", Date: "2025-05-01", RatingCount: 10, Score: 87.5,
+				Stats: domain.ScoreStats{RatingCount: 10, WeightedSum: 8.75, TotalWeight: 10, TotalSquaredWeight: 10}},
+			{CategoryName: "Grammer", Date: "2025-05-01", RatingCount: 12, Score: 90.0,
+				Stats: domain.ScoreStats{RatingCount: 12, WeightedSum: 10.8, TotalWeight: 12, TotalSquaredWeight: 12}},
+		}
+	}
+	expected := scores()
+	for i := range expected {
+		expected[i].SmoothedScore = expected[i].Score
+		expected[i].EffectiveSampleSize = float64(expected[i].RatingCount)
+		expected[i].Interval = scoring.ConfidenceInterval(expected[i].Stats, 0.95)
 	}
 
-	mockRepo.On("GetCategoryScores", mock.Anything, start, end).Return(expected, nil)
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).Return(scores(), nil)
 
-	result, err := scorer.GetCategoryScores(context.Background(), start, end)
+	result, err := scorer.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC, domain.ScoreOptions{ConfidenceLevel: 0.95})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expected, result)
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetCategoryScores_SmoothsTowardGlobalMean(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	start := time.Now().AddDate(0, 0, -7)
+	end := time.Now()
+
+	// One perfect rating next to a busy bucket scoring 50%, so the global mean
+	// is 55/110 = 50%
+	scores := []domain.CategoryScore{
+		{CategoryName: "GDPR", Date: "2025-05-01", RatingCount: 10, Score: 100,
+			Stats: domain.ScoreStats{RatingCount: 10, WeightedSum: 10, TotalWeight: 10, TotalSquaredWeight: 10}},
+		{CategoryName: "Grammar", Date: "2025-05-01", RatingCount: 100, Score: 45,
+			Stats: domain.ScoreStats{RatingCount: 100, WeightedSum: 45, TotalWeight: 100, TotalSquaredWeight: 100}},
+	}
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).Return(scores, nil)
+
+	opts := domain.ScoreOptions{Smoothing: domain.Smoothing{Mode: domain.SmoothingGlobalMean, Strength: 10}}
+	result, err := scorer.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, result[0].Score)
+	assert.InDelta(t, 75.0, result[0].SmoothedScore, 0.0001)
+	assert.InDelta(t, (100*45.0+10*50)/110, result[1].SmoothedScore, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func categoryTotal(name string, count int, score float64) domain.CategoryScore {
+	n := float64(count)
+	return domain.CategoryScore{
+		CategoryName: name,
+		RatingCount:  count,
+		Score:        score,
+		Stats:        domain.ScoreStats{RatingCount: count, WeightedSum: n * score / 100, TotalWeight: n, TotalSquaredWeight: n, WeightedSquaredSum: n * score / 100},
+	}
+}
+
+func TestGetCategoryPeriodComparison_OrdersByBiggestRegression(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	now := time.Now()
+	currentStart, currentEnd := now.AddDate(0, 0, -7), now
+	previousStart, previousEnd := now.AddDate(0, 0, -14), now.AddDate(0, 0, -7)
+
+	mockRepo.On("GetCategoryTotals", mock.Anything, currentStart, currentEnd, domain.TicketFilter{}).Return([]domain.CategoryScore{
+		categoryTotal("GDPR", 40, 70),
+		categoryTotal("Grammar", 50, 90),
+		categoryTotal("Randomness", 10, 60),
+		categoryTotal("Tone", 20, 85),
+	}, nil)
+	mockRepo.On("GetCategoryTotals", mock.Anything, previousStart, previousEnd, domain.TicketFilter{}).Return([]domain.CategoryScore{
+		categoryTotal("GDPR", 40, 80),
+		categoryTotal("Grammar", 50, 85),
+		categoryTotal("Spelling", 30, 75),
+		categoryTotal("Tone", 20, 90),
+	}, nil)
+
+	result, err := scorer.GetCategoryPeriodComparison(context.Background(), currentStart, currentEnd, previousStart, previousEnd,
+		domain.TicketFilter{}, domain.ComparisonOptions{ConfidenceLevel: 0.95, Alpha: 0.05})
+
+	assert.NoError(t, err)
+	var names []string
+	for _, c := range result {
+		names = append(names, c.CategoryName)
+	}
+	assert.Equal(t, []string{"GDPR", "Tone", "Grammar", "Randomness", "Spelling"}, names)
+
+	gdpr := result[0]
+	assert.InDelta(t, -10.0, gdpr.AbsoluteChange, 0.0001)
+	assert.InDelta(t, -12.5, gdpr.PercentageChange, 0.0001)
+	assert.Equal(t, 40, gdpr.CurrentCount)
+	assert.Equal(t, 40, gdpr.PreviousCount)
+	assert.Equal(t, domain.BaselineOK, gdpr.Baseline)
+
+	randomness := result[3]
+	assert.Equal(t, domain.BaselineNoRatings, randomness.Baseline)
+	assert.Equal(t, 0.0, randomness.PercentageChange)
+
+	spelling := result[4]
+	assert.Equal(t, 0, spelling.CurrentCount)
+	assert.Equal(t, 75.0, spelling.PreviousScore)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetCategoryPeriodComparison_Error(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	now := time.Now()
+	mockRepo.On("GetCategoryTotals", mock.Anything, mock.Anything, mock.Anything, domain.TicketFilter{}).
+		Return([]domain.CategoryScore(nil), errors.New("db error"))
+
+	result, err := scorer.GetCategoryPeriodComparison(context.Background(), now.AddDate(0, 0, -7), now, now.AddDate(0, 0, -14), now.AddDate(0, 0, -7),
+		domain.TicketFilter{}, domain.ComparisonOptions{ConfidenceLevel: 0.95, Alpha: 0.05})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
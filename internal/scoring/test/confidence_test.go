@@ -0,0 +1,49 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func TestConfidenceInterval_MatchesWilsonForBinaryRatings(t *testing.T) {
+	// 50 five-star and 50 zero-star ratings
+	stats := domain.ScoreStats{RatingCount: 100, WeightedSum: 50, TotalWeight: 100, TotalSquaredWeight: 100, WeightedSquaredSum: 50}
+
+	interval := scoring.ConfidenceInterval(stats, 0.95)
+
+	assert.InDelta(t, 40.38, interval.Lower, 0.01)
+	assert.InDelta(t, 59.62, interval.Upper, 0.01)
+	assert.Equal(t, 0.95, interval.Level)
+}
+
+func TestConfidenceInterval_SingleRatingIsWide(t *testing.T) {
+	stats := domain.ScoreStats{RatingCount: 1, WeightedSum: 1, TotalWeight: 1, TotalSquaredWeight: 1, WeightedSquaredSum: 1}
+
+	interval := scoring.ConfidenceInterval(stats, 0.95)
+
+	assert.InDelta(t, 20.65, interval.Lower, 0.01)
+	assert.Equal(t, 100.0, interval.Upper)
+}
+
+func TestConfidenceInterval_NarrowsWithLowerVarianceAndMoreRatings(t *testing.T) {
+	// Every rating is 4 stars, so the ratings do not vary at all
+	uniform := domain.ScoreStats{RatingCount: 100, WeightedSum: 80, TotalWeight: 100, TotalSquaredWeight: 100, WeightedSquaredSum: 64}
+	// Ratings of 0 and 5 stars with the same 80% mean
+	binary := domain.ScoreStats{RatingCount: 100, WeightedSum: 80, TotalWeight: 100, TotalSquaredWeight: 100, WeightedSquaredSum: 80}
+
+	width := func(i domain.ConfidenceInterval) float64 { return i.Upper - i.Lower }
+
+	assert.Less(t, width(scoring.ConfidenceInterval(uniform, 0.95)), width(scoring.ConfidenceInterval(binary, 0.95)))
+	assert.Less(t, width(scoring.ConfidenceInterval(binary.Add(binary), 0.95)), width(scoring.ConfidenceInterval(binary, 0.95)))
+	assert.Less(t, width(scoring.ConfidenceInterval(binary, 0.9)), width(scoring.ConfidenceInterval(binary, 0.99)))
+}
+
+func TestConfidenceInterval_NoRatings(t *testing.T) {
+	interval := scoring.ConfidenceInterval(domain.ScoreStats{}, 0.95)
+
+	assert.Equal(t, domain.ConfidenceInterval{Lower: 0, Upper: 100, Level: 0.95}, interval)
+}
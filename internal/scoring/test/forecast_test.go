@@ -0,0 +1,164 @@
+package scoring_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func weekScore(name, monday string, count int, score float64) domain.CategoryScore {
+	cs := dailyScore(name, monday, count, score)
+	cs.Date = domain.GranularityWeek.Label(day(monday))
+	return cs
+}
+
+// Wednesday of the week starting June 9th
+var forecastNow = time.Date(2025, 6, 11, 15, 0, 0, 0, time.UTC)
+
+func TestGetScoreForecast_ExtendsLinearTrend(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewForecastScorer(mockRepo)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-12"), day("2025-06-09"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore{
+			weekScore("GDPR", "2025-05-12", 10, 70),
+			weekScore("GDPR", "2025-05-19", 10, 75),
+			weekScore("GDPR", "2025-05-26", 10, 80),
+			weekScore("GDPR", "2025-06-02", 10, 85),
+		}, nil)
+
+	opts := domain.ForecastOptions{Weeks: 4, HistoryWeeks: 4, ConfidenceLevel: 0.95}
+	forecast, err := scorer.GetScoreForecast(context.Background(), forecastNow, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Empty(t, forecast.Categories)
+	assert.Len(t, forecast.Overall, 4)
+
+	first := forecast.Overall[0]
+	assert.Equal(t, domain.GranularityWeek.Label(day("2025-06-09")), first.Date)
+	assert.Equal(t, day("2025-06-09"), first.PeriodStart)
+	assert.Equal(t, day("2025-06-15"), first.PeriodEnd)
+
+	// A perfect fit leaves no prediction error
+	for i, expected := range []float64{90, 95, 100, 100} {
+		assert.InDelta(t, expected, forecast.Overall[i].Score, 0.0001)
+		assert.InDelta(t, expected, forecast.Overall[i].Interval.Lower, 0.0001)
+		assert.InDelta(t, expected, forecast.Overall[i].Interval.Upper, 0.0001)
+		assert.Equal(t, 0.95, forecast.Overall[i].Interval.Level)
+	}
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetScoreForecast_WidensWithHorizon(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewForecastScorer(mockRepo)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-04-28"), day("2025-06-09"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore{
+			weekScore("GDPR", "2025-04-28", 10, 50),
+			weekScore("GDPR", "2025-05-12", 10, 40),
+			weekScore("GDPR", "2025-05-19", 10, 60),
+			weekScore("GDPR", "2025-05-26", 10, 45),
+			weekScore("GDPR", "2025-06-02", 10, 55),
+			// Not enough weeks for a forecast of its own
+			weekScore("Grammar", "2025-05-26", 10, 60),
+			weekScore("Grammar", "2025-06-02", 10, 65),
+		}, nil)
+
+	opts := domain.ForecastOptions{Weeks: 3, HistoryWeeks: 6, ConfidenceLevel: 0.95, IncludeCategories: true}
+	forecast, err := scorer.GetScoreForecast(context.Background(), forecastNow, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Len(t, forecast.Categories, 1)
+	assert.Equal(t, "GDPR", forecast.Categories[0].CategoryName)
+	assert.Len(t, forecast.Overall, 3)
+
+	previousWidth := 0.0
+	for _, p := range forecast.Overall {
+		assert.Less(t, p.Interval.Lower, p.Score)
+		assert.Greater(t, p.Interval.Upper, p.Score)
+		width := p.Interval.Upper - p.Interval.Lower
+		assert.GreaterOrEqual(t, width, previousWidth)
+		previousWidth = width
+	}
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetScoreForecast_Categories(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewForecastScorer(mockRepo)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-19"), day("2025-06-09"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore{
+			weekScore("GDPR", "2025-05-19", 10, 90),
+			weekScore("GDPR", "2025-05-26", 10, 90),
+			weekScore("GDPR", "2025-06-02", 10, 90),
+			weekScore("Grammar", "2025-05-19", 10, 60),
+			weekScore("Grammar", "2025-05-26", 10, 50),
+			weekScore("Grammar", "2025-06-02", 10, 40),
+		}, nil)
+
+	opts := domain.ForecastOptions{Weeks: 1, HistoryWeeks: 3, ConfidenceLevel: 0.95, IncludeCategories: true}
+	forecast, err := scorer.GetScoreForecast(context.Background(), forecastNow, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	// Both categories weigh the same: 75%, 70% and 65%
+	assert.InDelta(t, 60.0, forecast.Overall[0].Score, 0.0001)
+	assert.Len(t, forecast.Categories, 2)
+	assert.Equal(t, "GDPR", forecast.Categories[0].CategoryName)
+	assert.InDelta(t, 90.0, forecast.Categories[0].Points[0].Score, 0.0001)
+	assert.Equal(t, "Grammar", forecast.Categories[1].CategoryName)
+	assert.InDelta(t, 30.0, forecast.Categories[1].Points[0].Score, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetScoreForecast_SkipsZeroWeightWeeks(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewForecastScorer(mockRepo)
+
+	zeroWeight := weekScore("GDPR", "2025-05-19", 10, 0)
+	zeroWeight.Stats = domain.ScoreStats{RatingCount: 10}
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-12"), day("2025-06-09"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore{
+			weekScore("GDPR", "2025-05-12", 10, 90),
+			zeroWeight,
+			weekScore("GDPR", "2025-05-26", 10, 90),
+			weekScore("GDPR", "2025-06-02", 10, 90),
+		}, nil)
+
+	opts := domain.ForecastOptions{Weeks: 1, HistoryWeeks: 4, ConfidenceLevel: 0.95, IncludeCategories: true}
+	forecast, err := scorer.GetScoreForecast(context.Background(), forecastNow, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 90.0, forecast.Overall[0].Score, 0.0001)
+	assert.Len(t, forecast.Categories, 1)
+	assert.InDelta(t, 90.0, forecast.Categories[0].Points[0].Score, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetScoreForecast_TooLittleHistory(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewForecastScorer(mockRepo)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-12"), day("2025-06-09"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore{
+			weekScore("GDPR", "2025-05-26", 10, 80),
+			weekScore("GDPR", "2025-06-02", 10, 85),
+		}, nil)
+
+	opts := domain.ForecastOptions{Weeks: 4, HistoryWeeks: 4, ConfidenceLevel: 0.95}
+	forecast, err := scorer.GetScoreForecast(context.Background(), forecastNow, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.Error(t, err)
+	assert.Nil(t, forecast)
+}
@@ -0,0 +1,119 @@
+package scoring_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+type mockLeaderboardRepo struct {
+	mock.Mock
+}
+
+func (m *mockLeaderboardRepo) GetLeaderboardScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, dimension domain.LeaderboardDimension) ([]domain.LeaderboardCategoryScore, error) {
+	args := m.Called(ctx, start, end, filter, dimension)
+	return args.Get(0).([]domain.LeaderboardCategoryScore), args.Error(1)
+}
+
+func leaderboardQuery() domain.LeaderboardQuery {
+	return domain.LeaderboardQuery{
+		Start:         time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC),
+		End:           time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC),
+		PreviousStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		PreviousEnd:   time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC),
+		Dimension:     domain.LeaderboardTeams,
+	}
+}
+
+func TestGetLeaderboard_RanksAndComparesWithPreviousPeriod(t *testing.T) {
+	mockRepo := new(mockLeaderboardRepo)
+	q := leaderboardQuery()
+	q.MinRatingCount = 2
+
+	mockRepo.On("GetLeaderboardScores", mock.Anything, q.Start, q.End, q.Filter, q.Dimension).Return([]domain.LeaderboardCategoryScore{
+		{Key: "billing", CategoryName: "GDPR", RatingCount: 2, WeightedSum: 1.0, TotalWeight: 2.0},
+		{Key: "billing", CategoryName: "Tone", RatingCount: 2, WeightedSum: 2.0, TotalWeight: 2.0},
+		{Key: "returns", CategoryName: "GDPR", RatingCount: 1, WeightedSum: 1.0, TotalWeight: 1.0}, // below the minimum
+		{Key: "sales", CategoryName: "GDPR", RatingCount: 3, WeightedSum: 2.7, TotalWeight: 3.0},
+		{Key: "shipping", CategoryName: "Tone", RatingCount: 4, WeightedSum: 3.0, TotalWeight: 4.0},
+	}, nil)
+	mockRepo.On("GetLeaderboardScores", mock.Anything, q.PreviousStart, q.PreviousEnd, q.Filter, q.Dimension).Return([]domain.LeaderboardCategoryScore{
+		{Key: "billing", CategoryName: "GDPR", RatingCount: 5, WeightedSum: 5.0, TotalWeight: 5.0},
+		{Key: "shipping", CategoryName: "Tone", RatingCount: 5, WeightedSum: 2.0, TotalWeight: 5.0},
+	}, nil)
+
+	entries, err := scoring.NewLeaderboardScorer(mockRepo).GetLeaderboard(context.Background(), q)
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	assert.Equal(t, "sales", entries[0].Key)
+	assert.Equal(t, 1, entries[0].Rank)
+	assert.InDelta(t, 90.0, entries[0].Score, 0.01)
+	assert.Equal(t, 0, entries[0].PreviousRank)
+	assert.Equal(t, 0, entries[0].RankChange)
+
+	// billing and shipping tie on 75%
+	assert.Equal(t, "billing", entries[1].Key)
+	assert.Equal(t, 2, entries[1].Rank)
+	assert.InDelta(t, 75.0, entries[1].Score, 0.01)
+	assert.Equal(t, 4, entries[1].RatingCount)
+	assert.Equal(t, 1, entries[1].PreviousRank)
+	assert.Equal(t, -1, entries[1].RankChange)
+	assert.Equal(t, []domain.LeaderboardCategory{
+		{CategoryName: "GDPR", Score: 50.0, RatingCount: 2},
+		{CategoryName: "Tone", Score: 100.0, RatingCount: 2},
+	}, entries[1].Categories)
+
+	assert.Equal(t, "shipping", entries[2].Key)
+	assert.Equal(t, 2, entries[2].Rank)
+	assert.Equal(t, 2, entries[2].PreviousRank)
+	assert.Equal(t, 0, entries[2].RankChange)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetLeaderboard_WorstFirstWithLimit(t *testing.T) {
+	mockRepo := new(mockLeaderboardRepo)
+	q := leaderboardQuery()
+	q.WorstFirst = true
+	q.Limit = 2
+
+	rows := []domain.LeaderboardCategoryScore{
+		{Key: "billing", CategoryName: "GDPR", RatingCount: 1, WeightedSum: 0.5, TotalWeight: 1.0},
+		{Key: "sales", CategoryName: "GDPR", RatingCount: 1, WeightedSum: 0.9, TotalWeight: 1.0},
+		{Key: "shipping", CategoryName: "GDPR", RatingCount: 1, WeightedSum: 0.2, TotalWeight: 1.0},
+	}
+	mockRepo.On("GetLeaderboardScores", mock.Anything, q.Start, q.End, q.Filter, q.Dimension).Return(rows, nil)
+	mockRepo.On("GetLeaderboardScores", mock.Anything, q.PreviousStart, q.PreviousEnd, q.Filter, q.Dimension).
+		Return([]domain.LeaderboardCategoryScore{}, nil)
+
+	entries, err := scoring.NewLeaderboardScorer(mockRepo).GetLeaderboard(context.Background(), q)
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "shipping", entries[0].Key)
+	assert.Equal(t, 3, entries[0].Rank)
+	assert.Equal(t, "billing", entries[1].Key)
+	assert.Equal(t, 2, entries[1].Rank)
+}
+
+func TestGetLeaderboard_Error(t *testing.T) {
+	mockRepo := new(mockLeaderboardRepo)
+	q := leaderboardQuery()
+
+	mockRepo.On("GetLeaderboardScores", mock.Anything, q.Start, q.End, q.Filter, q.Dimension).
+		Return([]domain.LeaderboardCategoryScore(nil), errors.New("db error"))
+
+	entries, err := scoring.NewLeaderboardScorer(mockRepo).GetLeaderboard(context.Background(), q)
+
+	assert.Error(t, err)
+	assert.Nil(t, entries)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"ticket-score-engine/internal/domain"
 	"ticket-score-engine/internal/scoring"
 )
 
@@ -16,11 +17,18 @@ type mockOverallRepo struct {
 	mock.Mock
 }
 
-func (m *mockOverallRepo) GetOverallScore(ctx context.Context, start, end time.Time) (float64, int, error) {
-	args := m.Called(ctx, start, end)
-	return args.Get(0).(float64), args.Int(1), args.Error(2)
+func (m *mockOverallRepo) GetOverallScore(ctx context.Context, start, end time.Time, filter domain.TicketFilter) (domain.ScoreStats, error) {
+	args := m.Called(ctx, start, end, filter)
+	return args.Get(0).(domain.ScoreStats), args.Error(1)
 }
 
+func (m *mockOverallRepo) GetDailyScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, loc *time.Location) ([]domain.DailyScoreStats, error) {
+	args := m.Called(ctx, start, end, filter, loc)
+	return args.Get(0).([]domain.DailyScoreStats), args.Error(1)
+}
+
+var comparisonOptions = domain.ComparisonOptions{ConfidenceLevel: 0.95, Alpha: 0.05}
+
 func TestGetOverallScore_Success(t *testing.T) {
 	mockRepo := new(mockOverallRepo)
 	scorer := scoring.NewOverallScorer(mockRepo)
@@ -31,14 +39,16 @@ func TestGetOverallScore_Success(t *testing.T) {
 	expectedScore := 85.5
 	expectedCount := 20
 
-	mockRepo.On("GetOverallScore", mock.Anything, start, end).Return(expectedScore, expectedCount, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: expectedCount, WeightedSum: 17.1, TotalWeight: 20, TotalSquaredWeight: 20}, nil)
 
-	result, err := scorer.GetOverallScore(context.Background(), start, end)
+	result, err := scorer.GetOverallScore(context.Background(), start, end, domain.TicketFilter{}, time.UTC, domain.ScoreOptions{})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, expectedScore, result.Score)
+	assert.InDelta(t, expectedScore, result.Score, 0.0001)
 	assert.Equal(t, expectedCount, result.RatingCount)
+	assert.InDelta(t, expectedScore, result.SmoothedScore, 0.0001)
+	assert.Equal(t, 20.0, result.EffectiveSampleSize)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -50,9 +60,9 @@ func TestGetOverallScore_Error(t *testing.T) {
 	start := time.Now().AddDate(0, 0, -7)
 	end := time.Now()
 
-	mockRepo.On("GetOverallScore", mock.Anything, start, end).Return(0.0, 0, errors.New("db error"))
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, domain.TicketFilter{}).Return(domain.ScoreStats{}, errors.New("db error"))
 
-	result, err := scorer.GetOverallScore(context.Background(), start, end)
+	result, err := scorer.GetOverallScore(context.Background(), start, end, domain.TicketFilter{}, time.UTC, domain.ScoreOptions{})
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -60,6 +70,52 @@ func TestGetOverallScore_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestGetOverallScore_SmoothsTowardPrior(t *testing.T) {
+	mockRepo := new(mockOverallRepo)
+	scorer := scoring.NewOverallScorer(mockRepo)
+
+	start := time.Now().AddDate(0, 0, -7)
+	end := time.Now()
+
+	// A single 5-star rating shrinks halfway toward the prior with strength 1
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, domain.TicketFilter{}).
+		Return(domain.ScoreStats{RatingCount: 1, WeightedSum: 1, TotalWeight: 1, TotalSquaredWeight: 1}, nil)
+
+	opts := domain.ScoreOptions{Smoothing: domain.Smoothing{Mode: domain.SmoothingPrior, Prior: 60, Strength: 1}}
+	result, err := scorer.GetOverallScore(context.Background(), start, end, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, result.Score)
+	assert.InDelta(t, 80.0, result.SmoothedScore, 0.0001)
+	assert.Equal(t, 1.0, result.EffectiveSampleSize)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetOverallScore_SmoothsFilteredTowardGlobalMean(t *testing.T) {
+	mockRepo := new(mockOverallRepo)
+	scorer := scoring.NewOverallScorer(mockRepo)
+
+	start := time.Now().AddDate(0, 0, -7)
+	end := time.Now()
+	filter := domain.TicketFilter{Teams: []string{"billing"}}
+
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, filter).
+		Return(domain.ScoreStats{RatingCount: 2, WeightedSum: 2, TotalWeight: 2, TotalSquaredWeight: 2}, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, domain.TicketFilter{}).
+		Return(domain.ScoreStats{RatingCount: 100, WeightedSum: 70, TotalWeight: 100, TotalSquaredWeight: 100}, nil)
+
+	opts := domain.ScoreOptions{Smoothing: domain.Smoothing{Mode: domain.SmoothingGlobalMean, Strength: 8}}
+	result, err := scorer.GetOverallScore(context.Background(), start, end, filter, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, result.Score)
+	// (2·100 + 8·70) / (2 + 8)
+	assert.InDelta(t, 76.0, result.SmoothedScore, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestGetPeriodComparison_Success(t *testing.T) {
 	mockRepo := new(mockOverallRepo)
 	scorer := scoring.NewOverallScorer(mockRepo)
@@ -70,10 +126,10 @@ func TestGetPeriodComparison_Success(t *testing.T) {
 	previousStart := now.AddDate(0, 0, -14)
 	previousEnd := now.AddDate(0, 0, -7)
 
-	mockRepo.On("GetOverallScore", mock.Anything, currentStart, currentEnd).Return(90.0, 10, nil)
-	mockRepo.On("GetOverallScore", mock.Anything, previousStart, previousEnd).Return(75.0, 8, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, currentStart, currentEnd, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 10, WeightedSum: 9, TotalWeight: 10, TotalSquaredWeight: 10, WeightedSquaredSum: 9}, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, previousStart, previousEnd, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 8, WeightedSum: 6, TotalWeight: 8, TotalSquaredWeight: 8, WeightedSquaredSum: 6}, nil)
 
-	result, err := scorer.GetPeriodComparison(context.Background(), currentStart, currentEnd, previousStart, previousEnd)
+	result, err := scorer.GetPeriodComparison(context.Background(), currentStart, currentEnd, previousStart, previousEnd, domain.TicketFilter{}, comparisonOptions)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -82,6 +138,16 @@ func TestGetPeriodComparison_Success(t *testing.T) {
 	assert.Equal(t, 75.0, result.PreviousScore)
 	assert.Equal(t, 10, result.CurrentCount)
 	assert.Equal(t, 8, result.PreviousCount)
+	assert.Equal(t, 0.95, result.CurrentInterval.Level)
+	assert.Less(t, result.CurrentInterval.Lower, 90.0)
+	assert.Greater(t, result.CurrentInterval.Upper, 90.0)
+	assert.Less(t, result.PreviousInterval.Lower, 75.0)
+	assert.Greater(t, result.PreviousInterval.Upper, 75.0)
+	assert.Equal(t, domain.BaselineOK, result.Baseline)
+	assert.InDelta(t, 15.0, result.AbsoluteChange, 0.0001)
+	// Ten and eight ratings are too few to tell 90% from 75%
+	assert.InDelta(t, 0.434, result.PValue, 0.001)
+	assert.False(t, result.IsSignificant)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -95,18 +161,62 @@ func TestGetPeriodComparison_HandlesZeroPreviousScore(t *testing.T) {
 	start2 := time.Now().AddDate(0, 0, -14)
 	end2 := time.Now().AddDate(0, 0, -7)
 
-	mockRepo.On("GetOverallScore", mock.Anything, start1, end1).Return(80.0, 5, nil)
-	mockRepo.On("GetOverallScore", mock.Anything, start2, end2).Return(0.0, 3, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start1, end1, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 5, WeightedSum: 4, TotalWeight: 5, TotalSquaredWeight: 5}, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start2, end2, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 3, TotalWeight: 3, TotalSquaredWeight: 3}, nil)
 
-	result, err := scorer.GetPeriodComparison(context.Background(), start1, end1, start2, end2)
+	result, err := scorer.GetPeriodComparison(context.Background(), start1, end1, start2, end2, domain.TicketFilter{}, comparisonOptions)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, float64(0), result.PercentageChange)
+	assert.Equal(t, domain.BaselineZeroScore, result.Baseline)
+	assert.Equal(t, 80.0, result.AbsoluteChange)
 	assert.Equal(t, 80.0, result.CurrentScore)
 	assert.Equal(t, 0.0, result.PreviousScore)
 }
 
+func TestGetPeriodComparison_NoBaseline(t *testing.T) {
+	mockRepo := new(mockOverallRepo)
+	scorer := scoring.NewOverallScorer(mockRepo)
+
+	start1 := time.Now().AddDate(0, 0, -7)
+	end1 := time.Now()
+	start2 := time.Now().AddDate(0, 0, -14)
+	end2 := time.Now().AddDate(0, 0, -7)
+
+	mockRepo.On("GetOverallScore", mock.Anything, start1, end1, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 5, WeightedSum: 4, TotalWeight: 5, TotalSquaredWeight: 5, WeightedSquaredSum: 4}, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start2, end2, domain.TicketFilter{}).Return(domain.ScoreStats{}, nil)
+
+	result, err := scorer.GetPeriodComparison(context.Background(), start1, end1, start2, end2, domain.TicketFilter{}, comparisonOptions)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BaselineNoRatings, result.Baseline)
+	assert.Equal(t, float64(0), result.PercentageChange)
+	assert.Equal(t, 1.0, result.PValue)
+	assert.False(t, result.IsSignificant)
+}
+
+func TestGetPeriodComparison_Significant(t *testing.T) {
+	mockRepo := new(mockOverallRepo)
+	scorer := scoring.NewOverallScorer(mockRepo)
+
+	start1 := time.Now().AddDate(0, 0, -7)
+	end1 := time.Now()
+	start2 := time.Now().AddDate(0, 0, -14)
+	end2 := time.Now().AddDate(0, 0, -7)
+
+	// 90% against 75% over a thousand ratings each
+	mockRepo.On("GetOverallScore", mock.Anything, start1, end1, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 1000, WeightedSum: 900, TotalWeight: 1000, TotalSquaredWeight: 1000, WeightedSquaredSum: 900}, nil)
+	mockRepo.On("GetOverallScore", mock.Anything, start2, end2, domain.TicketFilter{}).Return(domain.ScoreStats{RatingCount: 1000, WeightedSum: 750, TotalWeight: 1000, TotalSquaredWeight: 1000, WeightedSquaredSum: 750}, nil)
+
+	result, err := scorer.GetPeriodComparison(context.Background(), start1, end1, start2, end2, domain.TicketFilter{}, comparisonOptions)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 20.0, result.PercentageChange, 0.01)
+	assert.Less(t, result.PValue, 0.001)
+	assert.True(t, result.IsSignificant)
+}
+
 func TestGetComparisonPeriods_Invalid(t *testing.T) {
 	_, _, _, _, err := scoring.GetComparisonPeriods("year")
 	assert.Error(t, err)
@@ -0,0 +1,101 @@
+package scoring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func TestPeriodResolver_Presets(t *testing.T) {
+	// Sunday afternoon at the end of a leap-year March
+	now := time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC)
+	resolver := scoring.NewPeriodResolver(func() time.Time { return now })
+	date := func(y int, m time.Month, d, h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, time.UTC) }
+	periods := func(currentStart, currentEnd, previousStart, previousEnd time.Time) domain.ComparisonPeriods {
+		return domain.ComparisonPeriods{
+			CurrentStart:  currentStart,
+			CurrentEnd:    currentEnd,
+			PreviousStart: previousStart,
+			PreviousEnd:   previousEnd,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		preset      domain.PeriodPreset
+		rollingDays int
+		compareTo   domain.CompareTo
+		want        domain.ComparisonPeriods
+	}{
+		{"last 7 days", domain.PresetLast7Days, 0, domain.CompareToPreceding,
+			periods(date(2024, 3, 25, 0), now, date(2024, 3, 18, 0), date(2024, 3, 24, 15))},
+		{"week to date", domain.PresetWeekToDate, 0, domain.CompareToPreceding,
+			periods(date(2024, 3, 25, 0), now, date(2024, 3, 18, 0), date(2024, 3, 24, 15))},
+		{"month to date", domain.PresetMonthToDate, 0, domain.CompareToPreceding,
+			periods(date(2024, 3, 1, 0), now, date(2024, 2, 1, 0), date(2024, 2, 29, 15))},
+		{"quarter to date", domain.PresetQuarterToDate, 0, domain.CompareToPreceding,
+			periods(date(2024, 1, 1, 0), now, date(2023, 10, 1, 0), date(2023, 12, 31, 15))},
+		{"year to date", domain.PresetYearToDate, 0, domain.CompareToPreceding,
+			periods(date(2024, 1, 1, 0), now, date(2023, 1, 1, 0), date(2023, 3, 31, 15))},
+		{"rolling 28 days", domain.PresetRollingDays, 28, domain.CompareToPreceding,
+			periods(date(2024, 3, 4, 0), now, date(2024, 2, 5, 0), date(2024, 3, 3, 15))},
+		{"month to date against last year", domain.PresetMonthToDate, 0, domain.CompareToSamePeriodLastYear,
+			periods(date(2024, 3, 1, 0), now, date(2023, 3, 1, 0), date(2023, 3, 31, 15))},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolver.Resolve(tc.preset, tc.rollingDays, tc.compareTo, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPeriodResolver_TimeZone(t *testing.T) {
+	singapore, err := time.LoadLocation("Asia/Singapore")
+	require.NoError(t, err)
+
+	// Already April 1st in Singapore
+	now := time.Date(2024, 3, 31, 20, 0, 0, 0, time.UTC)
+	resolver := scoring.NewPeriodResolver(func() time.Time { return now })
+
+	got, err := resolver.Resolve(domain.PresetMonthToDate, 0, domain.CompareToPreceding, singapore)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 31, 16, 0, 0, 0, time.UTC), got.CurrentStart)
+	assert.Equal(t, now, got.CurrentEnd)
+	assert.Equal(t, time.Date(2024, 2, 29, 16, 0, 0, 0, time.UTC), got.PreviousStart)
+	assert.Equal(t, time.Date(2024, 2, 29, 20, 0, 0, 0, time.UTC), got.PreviousEnd)
+}
+
+func TestPeriodResolver_LeapDayLastYear(t *testing.T) {
+	now := time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+	resolver := scoring.NewPeriodResolver(func() time.Time { return now })
+
+	got, err := resolver.Resolve(domain.PresetYearToDate, 0, domain.CompareToSamePeriodLastYear, time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 2, 28, 12, 0, 0, 0, time.UTC), got.PreviousEnd)
+}
+
+func TestPeriodResolver_Invalid(t *testing.T) {
+	resolver := scoring.NewPeriodResolver(time.Now)
+
+	_, err := resolver.Resolve(domain.PresetRollingDays, 0, domain.CompareToPreceding, time.UTC)
+	assert.Error(t, err)
+	_, err = resolver.Resolve(domain.PresetNone, 0, domain.CompareToPreceding, time.UTC)
+	assert.Error(t, err)
+	_, err = resolver.Resolve(domain.PresetWeekToDate, 0, domain.CompareTo(7), time.UTC)
+	assert.Error(t, err)
+}
+
+func TestSamePeriodLastYear(t *testing.T) {
+	start, end := scoring.SamePeriodLastYear(
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	assert.Equal(t, time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), end)
+}
@@ -0,0 +1,116 @@
+package scoring_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func day(date string) time.Time {
+	t, _ := time.Parse("2006-01-02", date)
+	return t
+}
+
+func dailyScore(name, date string, count int, score float64) domain.CategoryScore {
+	cs := categoryTotal(name, count, score)
+	cs.Date = date
+	cs.PeriodStart = day(date)
+	cs.PeriodEnd = day(date)
+	return cs
+}
+
+func TestGetCategoryScores_RollingLooksBeforeRange(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	start, end := day("2025-05-03"), day("2025-05-05")
+
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-05-03", 1, 100),
+			dailyScore("GDPR", "2025-05-04", 1, 100),
+		}, nil)
+	mockRepo.On("GetCategoryScores", mock.Anything, day("2025-05-01"), start, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{dailyScore("GDPR", "2025-05-01", 1, 0)}, nil)
+
+	result, err := scorer.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC, domain.ScoreOptions{RollingDays: 3})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	// May 1-3, then May 2-4 after the 0% rating drops out
+	assert.InDelta(t, 50.0, result[0].RollingScore, 0.0001)
+	assert.Equal(t, 2, result[0].RollingRatingCount)
+	assert.InDelta(t, 100.0, result[1].RollingScore, 0.0001)
+	assert.Equal(t, 2, result[1].RollingRatingCount)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetCategoryScores_RollingNeedsDailyBuckets(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	_, err := scorer.GetCategoryScores(context.Background(), day("2025-05-01"), day("2025-06-01"), domain.TicketFilter{}, domain.GranularityWeek, time.UTC, domain.ScoreOptions{RollingDays: 7})
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "GetCategoryScores")
+}
+
+func TestGetCategoryScores_DecaysEarlierBuckets(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewCategoryScorer(mockRepo)
+
+	start, end := day("2025-05-03"), day("2025-05-05")
+
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).
+		Return([]domain.CategoryScore{
+			dailyScore("GDPR", "2025-05-03", 1, 100),
+			dailyScore("GDPR", "2025-05-04", 1, 0),
+			dailyScore("Grammar", "2025-05-04", 1, 80),
+		}, nil)
+
+	result, err := scorer.GetCategoryScores(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC, domain.ScoreOptions{DecayHalfLifeDays: 1})
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 100.0, result[0].DecayedScore, 0.0001)
+	// The day-old 100% counts half: (0.5·100 + 1·0) / 1.5
+	assert.InDelta(t, 100.0/3, result[1].DecayedScore, 0.0001)
+	assert.InDelta(t, 80.0, result[2].DecayedScore, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetOverallScore_RollingAndDecayed(t *testing.T) {
+	mockRepo := new(mockOverallRepo)
+	scorer := scoring.NewOverallScorer(mockRepo)
+
+	start, end := day("2025-05-03"), day("2025-05-05")
+	stats := func(count int, score float64) domain.ScoreStats {
+		return categoryTotal("", count, score).Stats
+	}
+
+	mockRepo.On("GetOverallScore", mock.Anything, start, end, domain.TicketFilter{}).Return(stats(2, 50), nil)
+	mockRepo.On("GetOverallScore", mock.Anything, day("2025-04-28"), end, domain.TicketFilter{}).Return(stats(10, 70), nil)
+	mockRepo.On("GetDailyScores", mock.Anything, start, end, domain.TicketFilter{}, time.UTC).
+		Return([]domain.DailyScoreStats{
+			{Day: day("2025-05-03"), Stats: stats(1, 100)},
+			{Day: day("2025-05-04"), Stats: stats(1, 0)},
+		}, nil)
+
+	opts := domain.ScoreOptions{RollingDays: 7, DecayHalfLifeDays: 1}
+	result, err := scorer.GetOverallScore(context.Background(), start, end, domain.TicketFilter{}, time.UTC, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50.0, result.Score)
+	assert.InDelta(t, 70.0, result.RollingScore, 0.0001)
+	assert.Equal(t, 10, result.RollingRatingCount)
+	assert.InDelta(t, 100.0/3, result.DecayedScore, 0.0001)
+
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,35 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func TestTwoSampleTest(t *testing.T) {
+	binary := func(n, fiveStars float64) domain.ScoreStats {
+		return domain.ScoreStats{RatingCount: int(n), WeightedSum: fiveStars, TotalWeight: n, TotalSquaredWeight: n, WeightedSquaredSum: fiveStars}
+	}
+
+	tests := []struct {
+		name   string
+		a, b   domain.ScoreStats
+		pValue float64
+	}{
+		{"same scores", binary(50, 40), binary(80, 64), 1},
+		{"one rating is no evidence", binary(1, 1), binary(100, 0), 1},
+		{"no ratings is no evidence", domain.ScoreStats{}, binary(100, 50), 1},
+		// z = 0.1 / sqrt(0.25/99 + 0.24/99) = 1.42
+		{"small difference", binary(100, 50), binary(100, 40), 0.1552},
+		{"constant ratings that differ", binary(10, 10), binary(10, 0), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.pValue, scoring.TwoSampleTest(tc.a, tc.b), 0.0001)
+			assert.InDelta(t, tc.pValue, scoring.TwoSampleTest(tc.b, tc.a), 0.0001)
+		})
+	}
+}
@@ -16,29 +16,82 @@ type mockTicketRepo struct {
 	mock.Mock
 }
 
-func (m *mockTicketRepo) GetScoresByTicket(ctx context.Context, start, end time.Time) ([]domain.TicketCategoryScore, error) {
-	args := m.Called(ctx, start, end)
-	return args.Get(0).([]domain.TicketCategoryScore), args.Error(1)
+// ScanScoresByTicket passes the rows given to Return on to fn
+func (m *mockTicketRepo) ScanScoresByTicket(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID, limit int, fn func(domain.TicketCategoryScore) error) error {
+	args := m.Called(ctx, start, end, filter, afterTicketID, limit)
+	for _, row := range args.Get(0).([]domain.TicketCategoryScore) {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
 }
 
-func TestGetTicketScores(t *testing.T) {
+func TestStreamTicketScores(t *testing.T) {
 	mockRepo := new(mockTicketRepo)
 	scorer := scoring.NewTicketScorer(mockRepo)
 
 	start := time.Now().AddDate(0, 0, -7)
 	end := time.Now()
 
-	expectedScores := []domain.TicketCategoryScore{
-		{TicketID: 1, CategoryName: "Responsiveness", Score: 85.0},
+	rows := []domain.TicketCategoryScore{
 		{TicketID: 1, CategoryName: "Knowledge", Score: 90.0},
+		{TicketID: 1, CategoryName: "Responsiveness", Score: 85.0},
+		{TicketID: 2, CategoryName: "Knowledge", Score: 70.0},
 	}
 
-	mockRepo.On("GetScoresByTicket", mock.Anything, start, end).Return(expectedScores, nil)
+	mockRepo.On("ScanScoresByTicket", mock.Anything, start, end, domain.TicketFilter{}, 0, 0).Return(rows, nil)
 
-	result, err := scorer.GetTicketScores(context.Background(), start, end)
+	var result []domain.TicketScore
+	err := scorer.StreamTicketScores(context.Background(), start, end, domain.TicketFilter{}, 0, func(ticket domain.TicketScore) error {
+		result = append(result, ticket)
+		return nil
+	})
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedScores, result)
+	assert.Equal(t, []domain.TicketScore{
+		{TicketID: 1, CategoryScores: map[string]float64{"Knowledge": 90.0, "Responsiveness": 85.0}},
+		{TicketID: 2, CategoryScores: map[string]float64{"Knowledge": 70.0}},
+	}, result)
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestGetTicketScoresPage(t *testing.T) {
+	start := time.Now().AddDate(0, 0, -7)
+	end := time.Now()
+
+	rows := []domain.TicketCategoryScore{
+		{TicketID: 3, CategoryName: "Knowledge", Score: 90.0},
+		{TicketID: 5, CategoryName: "Knowledge", Score: 80.0},
+		{TicketID: 5, CategoryName: "Tone", Score: 60.0},
+		{TicketID: 8, CategoryName: "Tone", Score: 40.0},
+	}
+
+	t.Run("more tickets after the page", func(t *testing.T) {
+		mockRepo := new(mockTicketRepo)
+		mockRepo.On("ScanScoresByTicket", mock.Anything, start, end, domain.TicketFilter{}, 2, 3).Return(rows, nil)
+
+		tickets, hasMore, err := scoring.NewTicketScorer(mockRepo).GetTicketScoresPage(context.Background(), start, end, domain.TicketFilter{}, 2, 2)
+
+		assert.NoError(t, err)
+		assert.True(t, hasMore)
+		assert.Len(t, tickets, 2)
+		assert.Equal(t, 3, tickets[0].TicketID)
+		assert.Equal(t, map[string]float64{"Knowledge": 80.0, "Tone": 60.0}, tickets[1].CategoryScores)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		mockRepo := new(mockTicketRepo)
+		mockRepo.On("ScanScoresByTicket", mock.Anything, start, end, domain.TicketFilter{}, 0, 4).Return(rows, nil)
+
+		tickets, hasMore, err := scoring.NewTicketScorer(mockRepo).GetTicketScoresPage(context.Background(), start, end, domain.TicketFilter{}, 0, 3)
+
+		assert.NoError(t, err)
+		assert.False(t, hasMore)
+		assert.Len(t, tickets, 3)
+		assert.Equal(t, 8, tickets[2].TicketID)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,106 @@
+package scoring_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+func bucketScore(name, date string, count int, score float64) domain.CategoryScore {
+	cs := categoryTotal(name, count, score)
+	cs.Date = date
+	return cs
+}
+
+func TestGetScoreTrend_FillsEmptyBuckets(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewTrendScorer(mockRepo)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityDay, time.UTC).Return([]domain.CategoryScore{
+		bucketScore("GDPR", "2024-05-01", 10, 60),
+		bucketScore("GDPR", "2024-05-03", 4, 50),
+		bucketScore("Grammar", "2024-05-01", 30, 100),
+	}, nil)
+
+	trend, err := scorer.GetScoreTrend(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityAuto, time.UTC, true, 0.95)
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.GranularityDay, trend.Granularity)
+	require.Len(t, trend.Overall, 4)
+	var dates []string
+	var counts []int
+	for _, p := range trend.Overall {
+		dates = append(dates, p.Date)
+		counts = append(counts, p.RatingCount)
+	}
+	assert.Equal(t, []string{"2024-05-01", "2024-05-02", "2024-05-03", "2024-05-04"}, dates)
+	assert.Equal(t, []int{40, 0, 4, 0}, counts)
+	// (6 + 30) / 40 ratings
+	assert.InDelta(t, 90.0, trend.Overall[0].Score, 0.0001)
+	assert.Equal(t, 0.0, trend.Overall[1].Score)
+	assert.Equal(t, domain.ConfidenceInterval{Lower: 0, Upper: 100, Level: 0.95}, trend.Overall[1].Interval)
+
+	require.Len(t, trend.Categories, 2)
+	assert.Equal(t, "GDPR", trend.Categories[0].CategoryName)
+	require.Len(t, trend.Categories[0].Points, 4)
+	assert.Equal(t, 4, trend.Categories[0].Points[2].RatingCount)
+	assert.Equal(t, "Grammar", trend.Categories[1].CategoryName)
+	assert.Equal(t, 0, trend.Categories[1].Points[2].RatingCount)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetScoreTrend_WeeklyBucketsClippedToRange(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewTrendScorer(mockRepo)
+
+	// Wednesday May 1st up to and including Tuesday May 14th
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityWeek, time.UTC).
+		Return([]domain.CategoryScore(nil), nil)
+
+	trend, err := scorer.GetScoreTrend(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityWeek, time.UTC, false, 0.95)
+	require.NoError(t, err)
+
+	require.Len(t, trend.Overall, 3)
+	assert.Equal(t, "2024-18", trend.Overall[0].Date)
+	assert.Equal(t, start, trend.Overall[0].PeriodStart)
+	assert.Equal(t, time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC), trend.Overall[0].PeriodEnd)
+	assert.Equal(t, "2024-20", trend.Overall[2].Date)
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), trend.Overall[2].PeriodStart)
+	assert.Equal(t, time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC), trend.Overall[2].PeriodEnd)
+	assert.Empty(t, trend.Categories)
+}
+
+func TestGetScoreTrend_MonthlyInTimeZone(t *testing.T) {
+	mockRepo := new(mockCategoryRepo)
+	scorer := scoring.NewTrendScorer(mockRepo)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, newYork)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, newYork)
+
+	mockRepo.On("GetCategoryScores", mock.Anything, start, end, domain.TicketFilter{}, domain.GranularityMonth, newYork).
+		Return([]domain.CategoryScore{bucketScore("GDPR", "2024-03", 2, 50)}, nil)
+
+	trend, err := scorer.GetScoreTrend(context.Background(), start, end, domain.TicketFilter{}, domain.GranularityMonth, newYork, false, 0.95)
+	require.NoError(t, err)
+
+	require.Len(t, trend.Overall, 3)
+	assert.Equal(t, []int{0, 0, 2}, []int{trend.Overall[0].RatingCount, trend.Overall[1].RatingCount, trend.Overall[2].RatingCount})
+	assert.Equal(t, "2024-03", trend.Overall[2].Date)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, newYork), trend.Overall[2].PeriodEnd)
+}
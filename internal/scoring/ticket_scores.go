@@ -16,6 +16,60 @@ func NewTicketScorer(repo repository.TicketRepository) *TicketScorer {
 	return &TicketScorer{repo: repo}
 }
 
-func (s *TicketScorer) GetTicketScores(ctx context.Context, start, end time.Time) ([]domain.TicketCategoryScore, error) {
-	return s.repo.GetScoresByTicket(ctx, start, end)
+// StreamTicketScores calls fn for every ticket matching filter rated in
+// [start, end) with an ID greater than afterTicketID, in ascending ticket ID order. Only one ticket is
+// held in memory at a time.
+func (s *TicketScorer) StreamTicketScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID int, fn func(domain.TicketScore) error) error {
+	return s.streamTicketScores(ctx, start, end, filter, afterTicketID, 0, fn)
+}
+
+// streamTicketScores is StreamTicketScores limited to the first limit tickets, or all of them if limit is 0
+func (s *TicketScorer) streamTicketScores(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID, limit int, fn func(domain.TicketScore) error) error {
+	var current *domain.TicketScore
+	err := s.repo.ScanScoresByTicket(ctx, start, end, filter, afterTicketID, limit, func(row domain.TicketCategoryScore) error {
+		if current != nil && current.TicketID == row.TicketID {
+			current.CategoryScores[row.CategoryName] = row.Score
+			return nil
+		}
+
+		// Rows are ordered by ticket, so the previous ticket is complete
+		if current != nil {
+			if err := fn(*current); err != nil {
+				return err
+			}
+		}
+		current = &domain.TicketScore{
+			TicketID:       row.TicketID,
+			CategoryScores: map[string]float64{row.CategoryName: row.Score},
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if current != nil {
+		return fn(*current)
+	}
+	return nil
+}
+
+// GetTicketScoresPage returns up to pageSize tickets matching filter following afterTicketID,
+// and whether there are more tickets after them
+func (s *TicketScorer) GetTicketScoresPage(ctx context.Context, start, end time.Time, filter domain.TicketFilter, afterTicketID, pageSize int) ([]domain.TicketScore, bool, error) {
+	var tickets []domain.TicketScore
+
+	// One ticket past the page is read to tell whether there are more
+	err := s.streamTicketScores(ctx, start, end, filter, afterTicketID, pageSize+1, func(ticket domain.TicketScore) error {
+		tickets = append(tickets, ticket)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(tickets) > pageSize {
+		return tickets[:pageSize], true, nil
+	}
+	return tickets, false, nil
 }
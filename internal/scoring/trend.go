@@ -0,0 +1,107 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/repository"
+)
+
+type TrendScorer struct {
+	repo repository.CategoryRepository
+}
+
+func NewTrendScorer(repo repository.CategoryRepository) *TrendScorer {
+	return &TrendScorer{repo: repo}
+}
+
+// GetScoreTrend returns the overall score of every bucket of the given
+// granularity in [start, end), following the calendar of loc, and optionally
+// that of every category rated in the range. All of it comes from the single
+// per-category query of the category repository: the overall score of a bucket
+// combines the sums of its categories.
+func (s *TrendScorer) GetScoreTrend(ctx context.Context, start, end time.Time, filter domain.TicketFilter, granularity domain.Granularity, loc *time.Location, includeCategories bool, confidenceLevel float64) (*domain.ScoreTrend, error) {
+	granularity = granularity.Resolve(start, end)
+	scores, err := s.repo.GetCategoryScores(ctx, start, end, filter, granularity, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category scores: %w", err)
+	}
+
+	buckets := trendBuckets(start.In(loc), end.In(loc), granularity)
+	index := make(map[string]int, len(buckets))
+	for i, b := range buckets {
+		index[b.Date] = i
+	}
+
+	overall := make([]domain.ScoreStats, len(buckets))
+	categories := make(map[string][]domain.ScoreStats)
+	var names []string
+	for _, cs := range scores {
+		i, ok := index[cs.Date]
+		if !ok {
+			return nil, fmt.Errorf("category score outside of the range: %s", cs.Date)
+		}
+		overall[i] = overall[i].Add(cs.Stats)
+
+		if !includeCategories {
+			continue
+		}
+		if _, ok := categories[cs.CategoryName]; !ok {
+			categories[cs.CategoryName] = make([]domain.ScoreStats, len(buckets))
+			names = append(names, cs.CategoryName)
+		}
+		categories[cs.CategoryName][i] = cs.Stats
+	}
+
+	trend := &domain.ScoreTrend{
+		Granularity: granularity,
+		Overall:     trendPoints(buckets, overall, confidenceLevel),
+	}
+	// The repository orders scores by category name
+	for _, name := range names {
+		trend.Categories = append(trend.Categories, domain.CategoryTrend{
+			CategoryName: name,
+			Points:       trendPoints(buckets, categories[name], confidenceLevel),
+		})
+	}
+
+	return trend, nil
+}
+
+// trendBuckets returns an empty point for every bucket overlapping
+// [start, end), with the days of the buckets clipped to the range
+func trendBuckets(start, end time.Time, granularity domain.Granularity) []domain.TrendPoint {
+	firstDay := domain.StartOfDay(start)
+	lastDay := domain.StartOfDay(end.Add(-time.Nanosecond))
+
+	var buckets []domain.TrendPoint
+	for bucket := granularity.BucketStart(start); bucket.Before(end); bucket = granularity.BucketEnd(bucket) {
+		point := domain.TrendPoint{
+			Date:        granularity.Label(bucket),
+			PeriodStart: bucket,
+			PeriodEnd:   granularity.BucketEnd(bucket).AddDate(0, 0, -1),
+		}
+		if firstDay.After(point.PeriodStart) {
+			point.PeriodStart = firstDay
+		}
+		if lastDay.Before(point.PeriodEnd) {
+			point.PeriodEnd = lastDay
+		}
+		buckets = append(buckets, point)
+	}
+	return buckets
+}
+
+func trendPoints(buckets []domain.TrendPoint, stats []domain.ScoreStats, confidenceLevel float64) []domain.TrendPoint {
+	points := make([]domain.TrendPoint, len(buckets))
+	for i, b := range buckets {
+		b.Stats = stats[i]
+		b.Score = stats[i].Score()
+		b.RatingCount = stats[i].RatingCount
+		b.Interval = ConfidenceInterval(stats[i], confidenceLevel)
+		points[i] = b
+	}
+	return points
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	pb "ticket-score-engine/generated"
+)
+
+func (s *ticketScoreServer) GetAnomalies(ctx context.Context, req *pb.AnomalyRequest) (*pb.AnomalyResponse, error) {
+	if req.Period == nil {
+		return nil, invalidField("period", "period is required")
+	}
+	r, err := parseScoreRange(req.Period)
+	if err != nil {
+		return nil, nestedField("period", err)
+	}
+
+	opts, err := parseAnomalyOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies, err := s.anomalyDetector.GetAnomalies(ctx, r.start, r.end, r.filter, r.loc, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect anomalies: %w", err)
+	}
+
+	resp := &pb.AnomalyResponse{}
+	for _, a := range anomalies {
+		resp.Anomalies = append(resp.Anomalies, &pb.Anomaly{
+			CategoryName:  a.CategoryName,
+			Date:          a.Day.Format(dateLayout),
+			Score:         float32(a.Score),
+			RatingCount:   int32(a.RatingCount),
+			ExpectedScore: float32(a.Expected),
+			ExpectedLower: float32(a.ExpectedLower),
+			ExpectedUpper: float32(a.ExpectedUpper),
+			ZScore:        a.ZScore,
+			Severity:      pb.AnomalySeverity(a.Severity),
+			BaselineDays:  int32(a.BaselineDays),
+		})
+	}
+
+	return resp, nil
+}
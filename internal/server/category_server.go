@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+)
+
+func (s *ticketScoreServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categories, err := s.categories.ListCategories(ctx, req.IncludeArchived, req.IncludeWeightHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	resp := &pb.ListCategoriesResponse{}
+	for _, category := range categories {
+		resp.Categories = append(resp.Categories, toPBCategory(&category))
+	}
+
+	return resp, nil
+}
+
+func (s *ticketScoreServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.RatingCategory, error) {
+	effectiveFrom, err := parseEffectiveFrom(req.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.categories.CreateCategory(ctx, req.Name, req.Weight, effectiveFrom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return toPBCategory(category), nil
+}
+
+func (s *ticketScoreServer) RenameCategory(ctx context.Context, req *pb.RenameCategoryRequest) (*pb.RatingCategory, error) {
+	category, err := s.categories.RenameCategory(ctx, int(req.CategoryId), req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename category: %w", err)
+	}
+
+	return toPBCategory(category), nil
+}
+
+func (s *ticketScoreServer) UpdateCategoryWeight(ctx context.Context, req *pb.UpdateCategoryWeightRequest) (*pb.RatingCategory, error) {
+	effectiveFrom, err := parseEffectiveFrom(req.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.categories.UpdateCategoryWeight(ctx, int(req.CategoryId), req.Weight, effectiveFrom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category weight: %w", err)
+	}
+
+	return toPBCategory(category), nil
+}
+
+func (s *ticketScoreServer) ArchiveCategory(ctx context.Context, req *pb.ArchiveCategoryRequest) (*pb.RatingCategory, error) {
+	category, err := s.categories.ArchiveCategory(ctx, int(req.CategoryId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive category: %w", err)
+	}
+
+	return toPBCategory(category), nil
+}
+
+// parseEffectiveFrom accepts either a date or a full timestamp. An empty value
+// yields the zero time, leaving the default to the category manager.
+func parseEffectiveFrom(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, invalidField("effective_from", "not a YYYY-MM-DD date or RFC3339 timestamp: %q", value)
+	}
+	return t, nil
+}
+
+func toPBCategory(category *domain.RatingCategory) *pb.RatingCategory {
+	resp := &pb.RatingCategory{
+		Id:       int32(category.ID),
+		Name:     category.Name,
+		Weight:   category.Weight,
+		Archived: category.ArchivedAt != nil,
+	}
+	if category.ArchivedAt != nil {
+		resp.ArchivedAt = category.ArchivedAt.UTC().Format(time.RFC3339)
+	}
+
+	for _, cw := range category.WeightHistory {
+		weight := &pb.CategoryWeight{
+			Weight:        cw.Weight,
+			EffectiveFrom: cw.EffectiveFrom.UTC().Format(time.RFC3339),
+		}
+		if cw.EffectiveTo != nil {
+			weight.EffectiveTo = cw.EffectiveTo.UTC().Format(time.RFC3339)
+		}
+		resp.WeightHistory = append(resp.WeightHistory, weight)
+	}
+
+	return resp
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ticket-score-engine/internal/domain"
+)
+
+// validationError lists the request fields that failed validation. It becomes
+// an InvalidArgument status with a BadRequest detail.
+type validationError struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (e *validationError) Error() string {
+	messages := make([]string, len(e.violations))
+	for i, v := range e.violations {
+		messages[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(messages, "; ")
+}
+
+// invalidField reports a request field that fails validation. Fields of nested
+// messages are separated by dots, e.g. "current_period.start_date".
+func invalidField(field, format string, args ...any) error {
+	return &validationError{violations: []*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	}}}
+}
+
+// nestedField moves the violations of a validation error into the message
+// field parent. Other errors are returned as they are.
+func nestedField(parent string, err error) error {
+	var v *validationError
+	if !errors.As(err, &v) {
+		return err
+	}
+	nested := &validationError{}
+	for _, violation := range v.violations {
+		nested.violations = append(nested.violations, &errdetails.BadRequest_FieldViolation{
+			Field:       parent + "." + violation.Field,
+			Description: violation.Description,
+		})
+	}
+	return nested
+}
+
+// domainFields are the request fields that domain validation errors are about
+var domainFields = map[error]string{
+	domain.ErrInvalidRating:       "rating",
+	domain.ErrInvalidTicketID:     "ticket_id",
+	domain.ErrInvalidCategoryID:   "rating_category_id",
+	domain.ErrUnknownCategory:     "rating_category_id",
+	domain.ErrInvalidCategoryName: "name",
+	domain.ErrInvalidWeight:       "weight",
+}
+
+// toStatus converts a handler error into a gRPC status. Validation errors
+// become InvalidArgument, domain errors their matching codes and cancelled or
+// timed out requests Canceled or DeadlineExceeded. Anything else is logged and
+// reported as Internal without its message, which may contain SQL.
+func toStatus(ctx context.Context, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var v *validationError
+	if errors.As(err, &v) {
+		return withBadRequest(codes.InvalidArgument, err.Error(), v.violations)
+	}
+	for sentinel, field := range domainFields {
+		if errors.Is(err, sentinel) {
+			return withBadRequest(codes.InvalidArgument, err.Error(), []*errdetails.BadRequest_FieldViolation{{
+				Field:       field,
+				Description: sentinel.Error(),
+			}})
+		}
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrCategoryArchived),
+		errors.Is(err, domain.ErrWeightEffectiveOrder),
+		errors.Is(err, domain.ErrNotEnoughHistory):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "request deadline exceeded")
+	}
+
+	// Drivers do not always wrap the context error when a query is interrupted
+	switch ctx.Err() {
+	case context.Canceled:
+		return status.Error(codes.Canceled, "request canceled")
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, "request deadline exceeded")
+	}
+
+	log.Printf("%s failed: %v", method, err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func withBadRequest(code codes.Code, message string, violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+)
+
+func (s *ticketScoreServer) GetScoreForecast(ctx context.Context, req *pb.ScoreForecastRequest) (*pb.ScoreForecastResponse, error) {
+	opts, err := parseForecastOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseTicketFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := s.forecastScorer.GetScoreForecast(ctx, s.now(), filter, loc, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to forecast scores: %w", err)
+	}
+
+	resp := &pb.ScoreForecastResponse{Overall: toPBForecastPoints(forecast.Overall)}
+	for _, c := range forecast.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryForecast{
+			CategoryName: c.CategoryName,
+			Points:       toPBForecastPoints(c.Points),
+		})
+	}
+
+	return resp, nil
+}
+
+func toPBForecastPoints(points []domain.ForecastPoint) []*pb.ForecastPoint {
+	result := make([]*pb.ForecastPoint, 0, len(points))
+	for _, p := range points {
+		result = append(result, &pb.ForecastPoint{
+			Date:               p.Date,
+			PeriodStart:        p.PeriodStart.Format(dateLayout),
+			PeriodEnd:          p.PeriodEnd.Format(dateLayout),
+			Score:              float32(p.Score),
+			PredictionInterval: toPBInterval(p.Interval),
+		})
+	}
+	return result
+}
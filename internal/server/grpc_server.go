@@ -7,57 +7,125 @@ import (
 	"time"
 
 	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/catalog"
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/ingestion"
 	"ticket-score-engine/internal/repository"
 	"ticket-score-engine/internal/scoring"
 )
 
 type ticketScoreServer struct {
 	pb.UnimplementedScoringServiceServer
-	categoryScorer *scoring.CategoryScorer
-	ticketScorer   *scoring.TicketScorer
-	overallScorer  *scoring.OverallScorer
-	db             *sql.DB
+	categoryScorer    *scoring.CategoryScorer
+	ticketScorer      *scoring.TicketScorer
+	overallScorer     *scoring.OverallScorer
+	leaderboardScorer *scoring.LeaderboardScorer
+	trendScorer       *scoring.TrendScorer
+	anomalyDetector   *scoring.AnomalyDetector
+	forecastScorer    *scoring.ForecastScorer
+	ratingIngester    *ingestion.RatingIngester
+	categories        *catalog.CategoryManager
+	periods           *scoring.PeriodResolver
+	now               func() time.Time
+	db                *sql.DB
 }
 
-func NewTicketScoreServer(db *sql.DB) pb.ScoringServiceServer {
-	repo := repository.NewCategoryRepository(db)
+// Option configures a server created by NewTicketScoreServer
+type Option func(*ticketScoreServer)
+
+// WithClock resolves period presets and forecasts against now instead of the
+// system clock
+func WithClock(now func() time.Time) Option {
+	return func(s *ticketScoreServer) {
+		s.periods = scoring.NewPeriodResolver(now)
+		s.now = now
+	}
+}
+
+func NewTicketScoreServer(db *sql.DB, dialect repository.Dialect, opts ...Option) pb.ScoringServiceServer {
+	repo := repository.NewCategoryRepository(db, dialect)
 	scorer := scoring.NewCategoryScorer(repo)
+	trendScorer := scoring.NewTrendScorer(repo)
+	anomalyDetector := scoring.NewAnomalyDetector(repo)
+	forecastScorer := scoring.NewForecastScorer(repo)
 
-	ticketRepo := repository.NewTicketRepository(db)
+	ticketRepo := repository.NewTicketRepository(db, dialect)
 	ticketScorer := scoring.NewTicketScorer(ticketRepo)
 
-	overallRepo := repository.NewOverallRepository(db)
+	overallRepo := repository.NewOverallRepository(db, dialect)
 	overallScorer := scoring.NewOverallScorer(overallRepo)
 
-	return &ticketScoreServer{
-		categoryScorer: scorer,
-		ticketScorer:   ticketScorer,
-		overallScorer:  overallScorer,
+	leaderboardRepo := repository.NewLeaderboardRepository(db, dialect)
+	leaderboardScorer := scoring.NewLeaderboardScorer(leaderboardRepo)
+
+	ratingRepo := repository.NewRatingRepository(db, dialect)
+	ratingIngester := ingestion.NewRatingIngester(ratingRepo)
+
+	ratingCategoryRepo := repository.NewRatingCategoryRepository(db, dialect)
+	categoryManager := catalog.NewCategoryManager(ratingCategoryRepo)
+
+	s := &ticketScoreServer{
+		categoryScorer:    scorer,
+		ticketScorer:      ticketScorer,
+		overallScorer:     overallScorer,
+		leaderboardScorer: leaderboardScorer,
+		trendScorer:       trendScorer,
+		anomalyDetector:   anomalyDetector,
+		forecastScorer:    forecastScorer,
+		ratingIngester:    ratingIngester,
+		categories:        categoryManager,
+		periods:           scoring.NewPeriodResolver(time.Now),
+		now:               time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 func (s *ticketScoreServer) GetCategoryScores(ctx context.Context, req *pb.ScoreRequest) (*pb.ScoreResponse, error) {
-	start, err := time.Parse("2006-01-02", req.StartDate)
+	r, err := parseScoreRange(req)
 	if err != nil {
 		return nil, err
 	}
-	end, err := time.Parse("2006-01-02", req.EndDate)
+
+	if _, ok := pb.Granularity_name[int32(req.Granularity)]; !ok {
+		return nil, invalidField("granularity", "invalid granularity: %d", req.Granularity)
+	}
+	granularity := domain.Granularity(req.Granularity).Resolve(r.start, r.end)
+
+	opts, err := parseScoreOptions(req)
 	if err != nil {
 		return nil, err
 	}
+	if opts.RollingDays > 0 && granularity != domain.GranularityDay {
+		return nil, invalidField("rolling_days", "needs daily buckets, not %s", granularity)
+	}
 
-	scores, err := s.categoryScorer.GetCategoryScores(ctx, start, end)
+	scores, err := s.categoryScorer.GetCategoryScores(ctx, r.start, r.end, r.filter, granularity, r.loc, opts)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to calculate category scores: %w", err)
 	}
 
-	var resp pb.ScoreResponse
+	resp := pb.ScoreResponse{
+		Granularity: pb.Granularity(granularity),
+		IsWeekly:    granularity == domain.GranularityWeek,
+	}
 	for _, s := range scores {
 		resp.Scores = append(resp.Scores, &pb.CategoryScore{
 			CategoryName: s.CategoryName,
 			Date:         s.Date,
 			Score:        float32(s.Score),
 			RatingCount:  int32(s.RatingCount),
+			PeriodStart:  s.PeriodStart.Format("2006-01-02"),
+			PeriodEnd:    s.PeriodEnd.Format("2006-01-02"),
+
+			SmoothedScore:       float32(s.SmoothedScore),
+			EffectiveSampleSize: s.EffectiveSampleSize,
+			ConfidenceInterval:  toPBInterval(s.Interval),
+			RollingScore:        float32(s.RollingScore),
+			RollingRatingCount:  int32(s.RollingRatingCount),
+			DecayedScore:        float32(s.DecayedScore),
 		})
 	}
 
@@ -65,95 +133,157 @@ func (s *ticketScoreServer) GetCategoryScores(ctx context.Context, req *pb.Score
 }
 
 func (s *ticketScoreServer) GetTicketScores(ctx context.Context, req *pb.ScoreRequest) (*pb.TicketScoreResponse, error) {
-	start, err := time.Parse("2006-01-02", req.StartDate)
+	r, err := parseScoreRange(req)
 	if err != nil {
 		return nil, err
 	}
-	end, err := time.Parse("2006-01-02", req.EndDate)
+	size, err := pageSize(req.PageSize)
 	if err != nil {
 		return nil, err
 	}
-
-	ticketCategoryScores, err := s.ticketScorer.GetTicketScores(ctx, start, end)
+	afterTicketID, err := decodePageToken(req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
-	// Group by TicketID
-	ticketMap := make(map[int64]map[string]float32)
-	for _, score := range ticketCategoryScores {
-		ticketID := int64(score.TicketID)
-		if _, exists := ticketMap[ticketID]; !exists {
-			ticketMap[ticketID] = make(map[string]float32)
-		}
-		ticketMap[ticketID][score.CategoryName] = float32(score.Score)
+	tickets, hasMore, err := s.ticketScorer.GetTicketScoresPage(ctx, r.start, r.end, r.filter, afterTicketID, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate ticket scores: %w", err)
 	}
 
-	var grpcTicketScores []*pb.TicketScore
-	for ticketID, categoryScores := range ticketMap {
-		grpcTicketScores = append(grpcTicketScores, &pb.TicketScore{
-			TicketId:       int32(ticketID),
-			CategoryScores: categoryScores,
-		})
+	resp := pb.TicketScoreResponse{}
+	for _, ticket := range tickets {
+		resp.TicketScores = append(resp.TicketScores, toPBTicketScore(ticket))
+	}
+	if hasMore {
+		resp.NextPageToken = encodePageToken(tickets[len(tickets)-1].TicketID)
 	}
 
-	return &pb.TicketScoreResponse{
-		TicketScores: grpcTicketScores,
-	}, nil
+	return &resp, nil
+}
+
+func (s *ticketScoreServer) StreamTicketScores(req *pb.ScoreRequest, stream pb.ScoringService_StreamTicketScoresServer) error {
+	r, err := parseScoreRange(req)
+	if err != nil {
+		return err
+	}
+	afterTicketID, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return err
+	}
+
+	return s.ticketScorer.StreamTicketScores(stream.Context(), r.start, r.end, r.filter, afterTicketID, func(ticket domain.TicketScore) error {
+		return stream.Send(toPBTicketScore(ticket))
+	})
+}
+
+func toPBTicketScore(ticket domain.TicketScore) *pb.TicketScore {
+	categoryScores := make(map[string]float32, len(ticket.CategoryScores))
+	for name, score := range ticket.CategoryScores {
+		categoryScores[name] = float32(score)
+	}
+
+	return &pb.TicketScore{
+		TicketId:       int32(ticket.TicketID),
+		CategoryScores: categoryScores,
+	}
 }
 
 func (s *ticketScoreServer) GetOverallScore(ctx context.Context, req *pb.ScoreRequest) (*pb.OverallScoreResponse, error) {
-	start, err := time.Parse("2006-01-02", req.StartDate)
+	r, err := parseScoreRange(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid start date: %w", err)
+		return nil, err
 	}
-	end, err := time.Parse("2006-01-02", req.EndDate)
+
+	opts, err := parseScoreOptions(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid end date: %w", err)
+		return nil, err
 	}
 
-	result, err := s.overallScorer.GetOverallScore(ctx, start, end)
+	result, err := s.overallScorer.GetOverallScore(ctx, r.start, r.end, r.filter, r.loc, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate overall score: %w", err)
 	}
 
 	return &pb.OverallScoreResponse{
-		Score:       float32(result.Score),
-		RatingCount: int32(result.RatingCount),
+		Score:               float32(result.Score),
+		RatingCount:         int32(result.RatingCount),
+		SmoothedScore:       float32(result.SmoothedScore),
+		EffectiveSampleSize: result.EffectiveSampleSize,
+		ConfidenceInterval:  toPBInterval(result.Interval),
+		RollingScore:        float32(result.RollingScore),
+		RollingRatingCount:  int32(result.RollingRatingCount),
+		DecayedScore:        float32(result.DecayedScore),
 	}, nil
 }
 
 func (s *ticketScoreServer) GetPeriodComparison(ctx context.Context, req *pb.PeriodComparisonRequest) (*pb.PeriodComparisonResponse, error) {
-	// Parse current period dates
-	currentStart, err := time.Parse("2006-01-02", req.CurrentPeriod.StartDate)
+	current, previous, opts, err := parsePeriodComparison(req, s.periods)
 	if err != nil {
-		return nil, fmt.Errorf("invalid current period start date: %w", err)
+		return nil, err
 	}
-	currentEnd, err := time.Parse("2006-01-02", req.CurrentPeriod.EndDate)
+
+	result, err := s.overallScorer.GetPeriodComparison(ctx, current.start, current.end, previous.start, previous.end, current.filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("invalid current period end date: %w", err)
+		return nil, fmt.Errorf("failed to compare periods: %w", err)
 	}
 
-	// Parse previous period dates
-	previousStart, err := time.Parse("2006-01-02", req.PreviousPeriod.StartDate)
+	resp := toPBPeriodComparison(*result)
+	resp.Periods = toPBResolvedPeriods(current, previous)
+	return resp, nil
+}
+
+func (s *ticketScoreServer) GetCategoryPeriodComparison(ctx context.Context, req *pb.PeriodComparisonRequest) (*pb.CategoryPeriodComparisonResponse, error) {
+	current, previous, opts, err := parsePeriodComparison(req, s.periods)
 	if err != nil {
-		return nil, fmt.Errorf("invalid previous period start date: %w", err)
+		return nil, err
 	}
-	previousEnd, err := time.Parse("2006-01-02", req.PreviousPeriod.EndDate)
+
+	comparisons, err := s.categoryScorer.GetCategoryPeriodComparison(ctx, current.start, current.end, previous.start, previous.end, current.filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("invalid previous period end date: %w", err)
+		return nil, fmt.Errorf("failed to compare category periods: %w", err)
 	}
 
-	result, err := s.overallScorer.GetPeriodComparison(ctx, currentStart, currentEnd, previousStart, previousEnd)
-	if err != nil {
-		return nil, fmt.Errorf("failed to compare periods: %w", err)
+	resp := pb.CategoryPeriodComparisonResponse{Periods: toPBResolvedPeriods(current, previous)}
+	for _, c := range comparisons {
+		resp.Categories = append(resp.Categories, &pb.CategoryComparison{
+			CategoryName: c.CategoryName,
+			Comparison:   toPBPeriodComparison(c.PeriodComparisonResult),
+		})
 	}
 
+	return &resp, nil
+}
+
+func toPBPeriodComparison(result domain.PeriodComparisonResult) *pb.PeriodComparisonResponse {
 	return &pb.PeriodComparisonResponse{
 		PercentageChange: float32(result.PercentageChange),
 		CurrentScore:     float32(result.CurrentScore),
 		PreviousScore:    float32(result.PreviousScore),
 		CurrentCount:     int32(result.CurrentCount),
 		PreviousCount:    int32(result.PreviousCount),
-	}, nil
+		CurrentInterval:  toPBInterval(result.CurrentInterval),
+		PreviousInterval: toPBInterval(result.PreviousInterval),
+		AbsoluteChange:   float32(result.AbsoluteChange),
+		PValue:           result.PValue,
+		IsSignificant:    result.IsSignificant,
+		Baseline:         pb.BaselineStatus(result.Baseline),
+	}
+}
+
+func toPBResolvedPeriods(current, previous scoreRange) *pb.ResolvedPeriods {
+	return &pb.ResolvedPeriods{
+		CurrentStart:  current.start.In(current.loc).Format(time.RFC3339),
+		CurrentEnd:    current.end.In(current.loc).Format(time.RFC3339),
+		PreviousStart: previous.start.In(current.loc).Format(time.RFC3339),
+		PreviousEnd:   previous.end.In(current.loc).Format(time.RFC3339),
+	}
+}
+
+func toPBInterval(interval domain.ConfidenceInterval) *pb.ConfidenceInterval {
+	return &pb.ConfidenceInterval{
+		Lower: float32(interval.Lower),
+		Upper: float32(interval.Upper),
+		Level: interval.Level,
+	}
 }
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LivenessService is the health service name liveness probes check. It stays
+// SERVING while the process runs, since restarting it does not bring back an
+// unreachable database.
+const LivenessService = "liveness"
+
+// HealthChecker reports the server ready through the gRPC health service
+// while the database can be reached: the overall status "" and that of the
+// scoring service follow its pings.
+type HealthChecker struct {
+	repo    repository.HealthRepository
+	health  *health.Server
+	timeout time.Duration
+	ready   bool
+}
+
+// NewHealthChecker returns a checker that reports NOT_SERVING until the first
+// successful ping. Each ping may take up to timeout.
+func NewHealthChecker(repo repository.HealthRepository, healthServer *health.Server, timeout time.Duration) *HealthChecker {
+	healthServer.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	c := &HealthChecker{repo: repo, health: healthServer, timeout: timeout}
+	c.setReady(false)
+	return c
+}
+
+// Run pings the database right away and then every interval until ctx is done
+func (c *HealthChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check pings the database once and updates the readiness, logging changes
+func (c *HealthChecker) Check(parent context.Context) error {
+	ctx, cancel := context.WithTimeout(parent, c.timeout)
+	defer cancel()
+
+	err := c.repo.Ping(ctx)
+	// Once shutting down the server stays not ready, even if the ping got through
+	if parent.Err() != nil {
+		return err
+	}
+	switch {
+	case err != nil && c.ready:
+		log.Printf("Not ready: %v", err)
+	case err == nil && !c.ready:
+		log.Println("Ready")
+	}
+	c.setReady(err == nil)
+	return err
+}
+
+func (c *HealthChecker) setReady(ready bool) {
+	c.ready = ready
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if ready {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	setReadiness(c.health, status)
+}
+
+// setReadiness sets the status readiness probes check, which leaves liveness alone
+func setReadiness(healthServer *health.Server, status healthpb.HealthCheckResponse_ServingStatus) {
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(pb.ScoringService_ServiceDesc.ServiceName, status)
+}
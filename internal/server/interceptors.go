@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ServerOptions are the options every gRPC server of the service is created
+// with. They recover from panics in handlers, turn handler errors into proper
+// status codes and give unary calls a deadline of requestTimeout, unless it
+// is 0 or the client's deadline is earlier.
+func ServerOptions(requestTimeout time.Duration) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryTimeoutInterceptor(requestTimeout), unaryErrorInterceptor, unaryRecoveryInterceptor),
+		grpc.ChainStreamInterceptor(streamErrorInterceptor, streamRecoveryInterceptor),
+	}
+}
+
+func unaryTimeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+func unaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(ctx, info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+func streamErrorInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, stream); err != nil {
+		return toStatus(stream.Context(), info.FullMethod, err)
+	}
+	return nil
+}
+
+// unaryRecoveryInterceptor turns a panicking handler into an error, which the
+// error interceptor reports as Internal, so one bad request cannot take the
+// server down
+func unaryRecoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer recoverHandler(info.FullMethod, &err)
+	return handler(ctx, req)
+}
+
+func streamRecoveryInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer recoverHandler(info.FullMethod, &err)
+	return handler(srv, stream)
+}
+
+// recoverHandler logs the stack of a panic and replaces the handler's error
+func recoverHandler(method string, err *error) {
+	if r := recover(); r != nil {
+		log.Printf("%s panicked: %v\n%s", method, r, debug.Stack())
+		*err = fmt.Errorf("%s panicked: %v", method, r)
+	}
+}
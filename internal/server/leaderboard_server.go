@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+)
+
+func (s *ticketScoreServer) GetLeaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.LeaderboardResponse, error) {
+	if req.Period == nil {
+		return nil, invalidField("period", "period is required")
+	}
+	current, err := parseScoreRange(req.Period)
+	if err != nil {
+		return nil, nestedField("period", err)
+	}
+
+	previous := precedingRange(current)
+	if req.PreviousPeriod != nil {
+		if previous, err = parseScoreRange(req.PreviousPeriod); err != nil {
+			return nil, nestedField("previous_period", err)
+		}
+	}
+
+	if _, ok := pb.LeaderboardDimension_name[int32(req.Dimension)]; !ok {
+		return nil, invalidField("dimension", "invalid leaderboard dimension: %d", req.Dimension)
+	}
+	if req.MinRatingCount < 0 {
+		return nil, invalidField("min_rating_count", "must not be negative: %d", req.MinRatingCount)
+	}
+	if req.Limit < 0 {
+		return nil, invalidField("limit", "must not be negative: %d", req.Limit)
+	}
+
+	entries, err := s.leaderboardScorer.GetLeaderboard(ctx, domain.LeaderboardQuery{
+		Start:          current.start,
+		End:            current.end,
+		PreviousStart:  previous.start,
+		PreviousEnd:    previous.end,
+		Filter:         current.filter,
+		Dimension:      domain.LeaderboardDimension(req.Dimension),
+		MinRatingCount: int(req.MinRatingCount),
+		Limit:          int(req.Limit),
+		WorstFirst:     req.WorstFirst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build leaderboard: %w", err)
+	}
+
+	resp := &pb.LeaderboardResponse{
+		PreviousPeriodStart: previous.start.In(current.loc).Format(time.RFC3339),
+		PreviousPeriodEnd:   previous.end.In(current.loc).Format(time.RFC3339),
+	}
+	for _, e := range entries {
+		entry := &pb.LeaderboardEntry{
+			Key:          e.Key,
+			Rank:         int32(e.Rank),
+			Score:        float32(e.Score),
+			RatingCount:  int32(e.RatingCount),
+			PreviousRank: int32(e.PreviousRank),
+			RankChange:   int32(e.RankChange),
+		}
+		for _, c := range e.Categories {
+			entry.Categories = append(entry.Categories, &pb.LeaderboardCategoryScore{
+				CategoryName: c.CategoryName,
+				Score:        float32(c.Score),
+				RatingCount:  int32(c.RatingCount),
+			})
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	return resp, nil
+}
+
+// precedingRange returns the range of the same length that ends where r
+// starts. Ranges of whole days are shifted by days in r's time zone, so they
+// stay aligned to midnight across DST changes.
+func precedingRange(r scoreRange) scoreRange {
+	start, end := r.start.In(r.loc), r.end.In(r.loc)
+	previous := r
+	previous.end = r.start
+
+	if start.Equal(domain.StartOfDay(start)) && end.Equal(domain.StartOfDay(end)) {
+		days := int(math.Round(end.Sub(start).Hours() / 24))
+		previous.start = start.AddDate(0, 0, -days).UTC()
+	} else {
+		previous.start = r.start.Add(-r.end.Sub(r.start))
+	}
+
+	return previous
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+)
+
+func (s *ticketScoreServer) SubmitRating(ctx context.Context, req *pb.SubmitRatingRequest) (*pb.SubmitRatingResponse, error) {
+	rating, err := ratingFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.ratingIngester.SubmitRating(ctx, rating)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit rating: %w", err)
+	}
+
+	return &pb.SubmitRatingResponse{RatingId: id}, nil
+}
+
+func (s *ticketScoreServer) BatchSubmitRatings(ctx context.Context, req *pb.BatchSubmitRatingsRequest) (*pb.BatchSubmitRatingsResponse, error) {
+	// Ratings that cannot be parsed are rejected up front and never reach the ingester
+	parseErrs := make(map[int]error)
+	var ratings []domain.Rating
+	var positions []int
+	for idx, r := range req.Ratings {
+		rating, err := ratingFromRequest(r)
+		if err != nil {
+			parseErrs[idx] = err
+			continue
+		}
+		ratings = append(ratings, rating)
+		positions = append(positions, idx)
+	}
+
+	results, err := s.ratingIngester.BatchSubmitRatings(ctx, ratings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit ratings: %w", err)
+	}
+
+	resp := &pb.BatchSubmitRatingsResponse{
+		Results: make([]*pb.RatingResult, len(req.Ratings)),
+	}
+	for idx, err := range parseErrs {
+		resp.Results[idx] = &pb.RatingResult{Index: int32(idx), Error: err.Error()}
+	}
+	for _, result := range results {
+		idx := positions[result.Index]
+		resp.Results[idx] = &pb.RatingResult{Index: int32(idx), RatingId: result.RatingID}
+		if result.Err != nil {
+			resp.Results[idx].Error = result.Err.Error()
+		}
+	}
+
+	for _, result := range resp.Results {
+		if result.Error != "" {
+			resp.RejectedCount++
+		} else {
+			resp.AcceptedCount++
+		}
+	}
+
+	return resp, nil
+}
+
+// ratingFromRequest converts a rating of a request. Batches may hold nil
+// ratings, which read as empty ones and fail validation.
+func ratingFromRequest(req *pb.SubmitRatingRequest) (domain.Rating, error) {
+	rating := domain.Rating{
+		TicketID:         int(req.GetTicketId()),
+		RatingCategoryID: int(req.GetRatingCategoryId()),
+		Rating:           int(req.GetRating()),
+		ReviewerID:       int(req.GetReviewerId()),
+		RevieweeID:       int(req.GetRevieweeId()),
+	}
+
+	if createdAt := req.GetCreatedAt(); createdAt != "" {
+		parsed, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil {
+			return domain.Rating{}, invalidField("created_at", "not an RFC3339 timestamp: %q", createdAt)
+		}
+		rating.CreatedAt = parsed
+	}
+
+	return rating, nil
+}
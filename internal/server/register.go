@@ -0,0 +1,20 @@
+package server
+
+import (
+	pb "ticket-score-engine/generated"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// RegisterServices registers the scoring and health services on grpcServer,
+// and the reflection service used by tools such as grpcurl when reflect is set
+func RegisterServices(grpcServer *grpc.Server, scoring pb.ScoringServiceServer, healthServer *health.Server, reflect bool) {
+	pb.RegisterScoringServiceServer(grpcServer, scoring)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if reflect {
+		reflection.Register(grpcServer)
+	}
+}
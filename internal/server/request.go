@@ -0,0 +1,414 @@
+package server
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+	"ticket-score-engine/internal/scoring"
+)
+
+const dateLayout = "2006-01-02"
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// maxRollingDays bounds the lookback of rolling scores to a year
+const maxRollingDays = 366
+
+// maxBaselineWeeks bounds the lookback of anomaly baselines to a year
+const maxBaselineWeeks = 52
+
+// maxComparisonDays bounds each period of a comparison to a year, leap day
+// included
+const maxComparisonDays = 366
+
+// maxTrendBuckets bounds the points of a trend series, a year of days
+const maxTrendBuckets = 366
+
+const (
+	maxForecastWeeks        = 26
+	minForecastHistoryWeeks = 3
+	maxForecastHistoryWeeks = 104
+)
+
+// scoreRange is the half-open time range [start, end) of a ScoreRequest and
+// its ticket filter. start and end are in UTC, loc is the time zone the
+// request was made in.
+type scoreRange struct {
+	start  time.Time
+	end    time.Time
+	loc    *time.Location
+	filter domain.TicketFilter
+}
+
+// parseScoreRange resolves the range of a request. Dates are days in the
+// request's time zone and the end date is included in full; timestamps are
+// used as given, with the end excluded.
+func parseScoreRange(req *pb.ScoreRequest) (scoreRange, error) {
+	loc, err := loadTimeZone(req.GetTimeZone())
+	if err != nil {
+		return scoreRange{}, err
+	}
+
+	start, err := parseBoundary(req.GetStartDate(), req.GetStartTime(), loc, "start")
+	if err != nil {
+		return scoreRange{}, err
+	}
+	end, err := parseBoundary(req.GetEndDate(), req.GetEndTime(), loc, "end")
+	if err != nil {
+		return scoreRange{}, err
+	}
+	if req.GetEndTime() == "" {
+		// The end date is inclusive, so the range ends at the following midnight
+		end = end.AddDate(0, 0, 1)
+	}
+
+	if !end.After(start) {
+		field := "end_date"
+		if req.GetEndTime() != "" {
+			field = "end_time"
+		}
+		return scoreRange{}, invalidField(field, "end must be after start")
+	}
+
+	filter, err := parseTicketFilter(req.GetFilter())
+	if err != nil {
+		return scoreRange{}, err
+	}
+
+	return scoreRange{start: start.UTC(), end: end.UTC(), loc: loc, filter: filter}, nil
+}
+
+// parseBoundary parses one end of a range given either as a date, which is
+// midnight in loc, or as an RFC3339 timestamp
+func parseBoundary(date, timestamp string, loc *time.Location, name string) (time.Time, error) {
+	switch {
+	case date != "" && timestamp != "":
+		return time.Time{}, invalidField(name+"_time", "%s_date and %s_time are mutually exclusive", name, name)
+	case timestamp != "":
+		t, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return time.Time{}, invalidField(name+"_time", "not an RFC3339 timestamp: %q", timestamp)
+		}
+		return t, nil
+	default:
+		t, err := time.ParseInLocation(dateLayout, date, loc)
+		if err != nil {
+			return time.Time{}, invalidField(name+"_date", "not a YYYY-MM-DD date: %q", date)
+		}
+		return t, nil
+	}
+}
+
+// parseTicketFilter converts an optional filter, rejecting blank values that
+// would never match
+func parseTicketFilter(f *pb.TicketFilter) (domain.TicketFilter, error) {
+	filter := domain.TicketFilter{
+		Teams:      f.GetTeams(),
+		Channels:   f.GetChannels(),
+		Priorities: f.GetPriorities(),
+		Tags:       f.GetTags(),
+	}
+	for _, id := range f.GetAssigneeIds() {
+		if id <= 0 {
+			return domain.TicketFilter{}, invalidField("filter.assignee_ids", "invalid assignee id: %d", id)
+		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, int(id))
+	}
+
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"teams", filter.Teams},
+		{"channels", filter.Channels},
+		{"priorities", filter.Priorities},
+		{"tags", filter.Tags},
+	} {
+		for _, v := range field.values {
+			if strings.TrimSpace(v) == "" {
+				return domain.TicketFilter{}, invalidField("filter."+field.name, "contains an empty value")
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+// parseScoreOptions converts how a request wants its scores reported
+func parseScoreOptions(req *pb.ScoreRequest) (domain.ScoreOptions, error) {
+	smoothing, err := parseSmoothing(req.GetSmoothing())
+	if err != nil {
+		return domain.ScoreOptions{}, err
+	}
+	level, err := parseConfidenceLevel(req.GetConfidenceLevel())
+	if err != nil {
+		return domain.ScoreOptions{}, err
+	}
+	if req.GetRollingDays() < 0 || req.GetRollingDays() > maxRollingDays {
+		return domain.ScoreOptions{}, invalidField("rolling_days", "must be between 1 and %d, or 0 for no rolling scores", maxRollingDays)
+	}
+	halfLife := req.GetDecayHalfLifeDays()
+	if halfLife < 0 || math.IsNaN(halfLife) || math.IsInf(halfLife, 0) {
+		return domain.ScoreOptions{}, invalidField("decay_half_life_days", "invalid half-life: %v", halfLife)
+	}
+	return domain.ScoreOptions{
+		Smoothing:         smoothing,
+		ConfidenceLevel:   level,
+		RollingDays:       int(req.GetRollingDays()),
+		DecayHalfLifeDays: halfLife,
+	}, nil
+}
+
+// parsePeriodComparison resolves both periods of a comparison and its options.
+// The time zone and filter of the current period apply to both.
+func parsePeriodComparison(req *pb.PeriodComparisonRequest, periods *scoring.PeriodResolver) (current, previous scoreRange, opts domain.ComparisonOptions, err error) {
+	fail := func(err error) (scoreRange, scoreRange, domain.ComparisonOptions, error) {
+		return scoreRange{}, scoreRange{}, domain.ComparisonOptions{}, err
+	}
+
+	if _, ok := pb.PeriodPreset_name[int32(req.Preset)]; !ok {
+		return fail(invalidField("preset", "invalid period preset: %d", req.Preset))
+	}
+	if _, ok := pb.CompareTo_name[int32(req.CompareTo)]; !ok {
+		return fail(invalidField("compare_to", "invalid compare to: %d", req.CompareTo))
+	}
+	if req.RollingDays != 0 && req.Preset != pb.PeriodPreset_PERIOD_PRESET_ROLLING_DAYS {
+		return fail(invalidField("rolling_days", "only used with PERIOD_PRESET_ROLLING_DAYS"))
+	}
+	if req.Preset == pb.PeriodPreset_PERIOD_PRESET_ROLLING_DAYS && (req.RollingDays <= 0 || req.RollingDays > maxRollingDays) {
+		return fail(invalidField("rolling_days", "must be between 1 and %d", maxRollingDays))
+	}
+	derivePrevious := req.Preset != pb.PeriodPreset_PERIOD_PRESET_NONE || req.CompareTo != pb.CompareTo_COMPARE_TO_PRECEDING
+	if derivePrevious && hasRange(req.PreviousPeriod) {
+		return fail(invalidField("previous_period", "cannot have dates with a preset or compare_to"))
+	}
+
+	if req.Preset == pb.PeriodPreset_PERIOD_PRESET_NONE {
+		if req.CurrentPeriod == nil {
+			return fail(invalidField("current_period", "current_period is required without a preset"))
+		}
+		if current, err = parseScoreRange(req.CurrentPeriod); err != nil {
+			return fail(nestedField("current_period", err))
+		}
+		if err = checkRangeLength(current, maxComparisonDays, "current_period"); err != nil {
+			return fail(err)
+		}
+
+		previous = current
+		if req.CompareTo == pb.CompareTo_COMPARE_TO_SAME_PERIOD_LAST_YEAR {
+			previous.start, previous.end = scoring.SamePeriodLastYear(current.start, current.end, current.loc)
+		} else {
+			if req.PreviousPeriod == nil {
+				return fail(invalidField("previous_period", "previous_period is required without a preset or compare_to"))
+			}
+			if previous, err = parseScoreRange(req.PreviousPeriod); err != nil {
+				return fail(nestedField("previous_period", err))
+			}
+			if err = checkRangeLength(previous, maxComparisonDays, "previous_period"); err != nil {
+				return fail(err)
+			}
+		}
+	} else {
+		if hasRange(req.CurrentPeriod) {
+			return fail(invalidField("current_period", "cannot have dates with a preset"))
+		}
+		if current.loc, err = loadTimeZone(req.CurrentPeriod.GetTimeZone()); err != nil {
+			return fail(nestedField("current_period", err))
+		}
+		if current.filter, err = parseTicketFilter(req.CurrentPeriod.GetFilter()); err != nil {
+			return fail(nestedField("current_period", err))
+		}
+
+		resolved, err := periods.Resolve(domain.PeriodPreset(req.Preset), int(req.RollingDays), domain.CompareTo(req.CompareTo), current.loc)
+		if err != nil {
+			return fail(err)
+		}
+		previous = current
+		current.start, current.end = resolved.CurrentStart, resolved.CurrentEnd
+		previous.start, previous.end = resolved.PreviousStart, resolved.PreviousEnd
+	}
+
+	// Ratings in both periods would count on both sides of the comparison
+	if previous.start.Before(current.end) && current.start.Before(previous.end) {
+		field := "previous_period"
+		if derivePrevious {
+			field = "compare_to"
+		}
+		return fail(invalidField(field, "the previous period overlaps the current one"))
+	}
+
+	if opts, err = parseComparisonOptions(req); err != nil {
+		return fail(err)
+	}
+	return current, previous, opts, nil
+}
+
+// checkRangeLength rejects ranges longer than maxDays days in their time zone
+func checkRangeLength(r scoreRange, maxDays int, field string) error {
+	if r.start.In(r.loc).AddDate(0, 0, maxDays).Before(r.end.In(r.loc)) {
+		return invalidField(field, "must not be longer than %d days", maxDays)
+	}
+	return nil
+}
+
+// hasRange reports whether a request sets any of its dates or timestamps
+func hasRange(req *pb.ScoreRequest) bool {
+	return req.GetStartDate() != "" || req.GetEndDate() != "" || req.GetStartTime() != "" || req.GetEndTime() != ""
+}
+
+// parseComparisonOptions converts the settings of a period comparison. The
+// confidence level is that of the current period.
+func parseComparisonOptions(req *pb.PeriodComparisonRequest) (domain.ComparisonOptions, error) {
+	level, err := parseConfidenceLevel(req.GetCurrentPeriod().GetConfidenceLevel())
+	if err != nil {
+		return domain.ComparisonOptions{}, nestedField("current_period", err)
+	}
+
+	alpha := req.GetAlpha()
+	switch {
+	case alpha == 0:
+		alpha = domain.DefaultSignificanceLevel
+	case alpha < 0 || alpha >= 1:
+		return domain.ComparisonOptions{}, invalidField("alpha", "must be between 0 and 1: %g", alpha)
+	}
+
+	return domain.ComparisonOptions{ConfidenceLevel: level, Alpha: alpha}, nil
+}
+
+// parseConfidenceLevel validates a confidence level, where 0 means the default
+func parseConfidenceLevel(level float64) (float64, error) {
+	switch {
+	case level == 0:
+		return domain.DefaultConfidenceLevel, nil
+	case level < 0 || level >= 1:
+		return 0, invalidField("confidence_level", "must be between 0 and 1: %g", level)
+	default:
+		return level, nil
+	}
+}
+
+// parseSmoothing converts optional smoothing settings, defaulting the strength
+func parseSmoothing(s *pb.Smoothing) (domain.Smoothing, error) {
+	if _, ok := pb.SmoothingMode_name[int32(s.GetMode())]; !ok {
+		return domain.Smoothing{}, invalidField("smoothing.mode", "invalid smoothing mode: %d", s.GetMode())
+	}
+	if s.GetPriorScore() < 0 || s.GetPriorScore() > 100 {
+		return domain.Smoothing{}, invalidField("smoothing.prior_score", "must be between 0 and 100: %g", s.GetPriorScore())
+	}
+	if s.GetStrength() < 0 {
+		return domain.Smoothing{}, invalidField("smoothing.strength", "must not be negative: %g", s.GetStrength())
+	}
+
+	smoothing := domain.Smoothing{
+		Mode:     domain.SmoothingMode(s.GetMode()),
+		Prior:    s.GetPriorScore(),
+		Strength: s.GetStrength(),
+	}
+	if smoothing.Strength == 0 {
+		smoothing.Strength = domain.DefaultSmoothingStrength
+	}
+	return smoothing, nil
+}
+
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, invalidField("time_zone", "unknown time zone: %q", name)
+	}
+	return loc, nil
+}
+
+// pageSize returns the number of tickets per page for a requested size
+func pageSize(requested int32) (int, error) {
+	switch {
+	case requested < 0:
+		return 0, invalidField("page_size", "must not be negative: %d", requested)
+	case requested == 0:
+		return defaultPageSize, nil
+	case requested > maxPageSize:
+		return maxPageSize, nil
+	default:
+		return int(requested), nil
+	}
+}
+
+// encodePageToken returns an opaque token continuing after the given ticket
+func encodePageToken(lastTicketID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(lastTicketID)))
+}
+
+// decodePageToken returns the ticket ID a page token continues after, 0 for
+// the first page
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalidField("page_token", "invalid page token")
+	}
+	id, err := strconv.Atoi(string(raw))
+	if err != nil || id <= 0 {
+		return 0, invalidField("page_token", "invalid page token")
+	}
+	return id, nil
+}
+
+// parseAnomalyOptions converts the settings of anomaly detection, where 0
+// stands for the defaults
+func parseAnomalyOptions(req *pb.AnomalyRequest) (domain.AnomalyOptions, error) {
+	opts := domain.AnomalyOptions{Threshold: req.GetThreshold(), BaselineWeeks: int(req.GetBaselineWeeks())}
+	if opts.Threshold == 0 {
+		opts.Threshold = domain.DefaultAnomalyThreshold
+	}
+	if opts.Threshold < 0 || math.IsNaN(opts.Threshold) || math.IsInf(opts.Threshold, 0) {
+		return domain.AnomalyOptions{}, invalidField("threshold", "invalid threshold: %v", opts.Threshold)
+	}
+	if opts.BaselineWeeks == 0 {
+		opts.BaselineWeeks = domain.DefaultBaselineWeeks
+	}
+	if opts.BaselineWeeks < 0 || opts.BaselineWeeks > maxBaselineWeeks {
+		return domain.AnomalyOptions{}, invalidField("baseline_weeks", "must be between 1 and %d, or 0 for the default of %d", maxBaselineWeeks, domain.DefaultBaselineWeeks)
+	}
+	return opts, nil
+}
+
+// parseForecastOptions converts the settings of a forecast, where 0 stands for
+// the defaults
+func parseForecastOptions(req *pb.ScoreForecastRequest) (domain.ForecastOptions, error) {
+	opts := domain.ForecastOptions{
+		Weeks:             int(req.GetWeeks()),
+		HistoryWeeks:      int(req.GetHistoryWeeks()),
+		IncludeCategories: req.GetIncludeCategories(),
+	}
+	if opts.Weeks == 0 {
+		opts.Weeks = domain.DefaultForecastWeeks
+	}
+	if opts.Weeks < 0 || opts.Weeks > maxForecastWeeks {
+		return domain.ForecastOptions{}, invalidField("weeks", "must be between 1 and %d", maxForecastWeeks)
+	}
+	if opts.HistoryWeeks == 0 {
+		opts.HistoryWeeks = domain.DefaultForecastHistoryWeeks
+	}
+	if opts.HistoryWeeks < minForecastHistoryWeeks || opts.HistoryWeeks > maxForecastHistoryWeeks {
+		return domain.ForecastOptions{}, invalidField("history_weeks", "must be between %d and %d", minForecastHistoryWeeks, maxForecastHistoryWeeks)
+	}
+
+	var err error
+	if opts.ConfidenceLevel, err = parseConfidenceLevel(req.GetConfidenceLevel()); err != nil {
+		return domain.ForecastOptions{}, err
+	}
+	return opts, nil
+}
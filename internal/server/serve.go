@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Serve runs grpcServer on lis until ctx is done, then drains it: readiness
+// turns NOT_SERVING, calls are still accepted for drainDelay so that load
+// balancers notice and send new calls elsewhere, then no new calls are
+// accepted and running calls get drainTimeout to finish before they are cut
+// off, or as long as they take when drainTimeout is 0. Liveness stays SERVING
+// until the calls are drained, so the drain is not taken for a hung process.
+// It returns once the server has stopped, so resources used by handlers can be
+// released after it.
+func Serve(ctx context.Context, grpcServer *grpc.Server, lis net.Listener, healthServer *health.Server, drainDelay, drainTimeout time.Duration) error {
+	served := make(chan error, 1)
+	go func() {
+		served <- grpcServer.Serve(lis)
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	if drainTimeout > 0 {
+		log.Printf("Shutting down, draining calls for up to %s", drainTimeout)
+	} else {
+		log.Printf("Shutting down, draining calls")
+	}
+	setReadiness(healthServer, healthpb.HealthCheckResponse_NOT_SERVING)
+	if drainDelay > 0 {
+		time.Sleep(drainDelay)
+	}
+
+	drain(grpcServer, drainTimeout)
+	healthServer.Shutdown()
+	return <-served
+}
+
+// drain stops grpcServer gracefully, cutting off the calls still running
+// after timeout unless it is 0
+func drain(grpcServer *grpc.Server, timeout time.Duration) {
+	drained := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(drained)
+	}()
+
+	if timeout == 0 {
+		<-drained
+		return
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-drained:
+	case <-timer.C:
+		log.Printf("Calls still running after %s, stopping", timeout)
+		grpcServer.Stop()
+		<-drained
+	}
+}
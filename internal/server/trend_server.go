@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/domain"
+)
+
+func (s *ticketScoreServer) GetScoreTrend(ctx context.Context, req *pb.ScoreTrendRequest) (*pb.ScoreTrendResponse, error) {
+	if req.Period == nil {
+		return nil, invalidField("period", "period is required")
+	}
+	r, err := parseScoreRange(req.Period)
+	if err != nil {
+		return nil, nestedField("period", err)
+	}
+
+	granularity := domain.Granularity(req.Period.Granularity)
+	switch granularity {
+	case domain.GranularityDay, domain.GranularityWeek, domain.GranularityMonth:
+	default:
+		return nil, invalidField("period.granularity", "must be day, week or month")
+	}
+	if trendBucketCount(r, granularity, maxTrendBuckets) > maxTrendBuckets {
+		return nil, invalidField("period", "must not span more than %d %s buckets", maxTrendBuckets, granularity)
+	}
+	level, err := parseConfidenceLevel(req.Period.ConfidenceLevel)
+	if err != nil {
+		return nil, nestedField("period", err)
+	}
+
+	trend, err := s.trendScorer.GetScoreTrend(ctx, r.start, r.end, r.filter, granularity, r.loc, req.IncludeCategories, level)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score trend: %w", err)
+	}
+
+	resp := &pb.ScoreTrendResponse{
+		Granularity: pb.Granularity(trend.Granularity),
+		Overall:     toPBTrendPoints(trend.Overall),
+	}
+	for _, c := range trend.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryTrend{
+			CategoryName: c.CategoryName,
+			Points:       toPBTrendPoints(c.Points),
+		})
+	}
+
+	return resp, nil
+}
+
+// trendBucketCount counts the buckets of r at granularity, stopping once it
+// exceeds limit
+func trendBucketCount(r scoreRange, granularity domain.Granularity, limit int) int {
+	end := r.end.In(r.loc)
+	count := 0
+	for bucket := granularity.BucketStart(r.start.In(r.loc)); bucket.Before(end) && count <= limit; bucket = granularity.BucketEnd(bucket) {
+		count++
+	}
+	return count
+}
+
+func toPBTrendPoints(points []domain.TrendPoint) []*pb.TrendPoint {
+	result := make([]*pb.TrendPoint, 0, len(points))
+	for _, p := range points {
+		result = append(result, &pb.TrendPoint{
+			Date:               p.Date,
+			PeriodStart:        p.PeriodStart.Format(dateLayout),
+			PeriodEnd:          p.PeriodEnd.Format(dateLayout),
+			Score:              float32(p.Score),
+			RatingCount:        int32(p.RatingCount),
+			ConfidenceInterval: toPBInterval(p.Interval),
+		})
+	}
+	return result
+}
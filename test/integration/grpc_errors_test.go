@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/server"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// fieldViolations returns the fields of the BadRequest detail of err
+func fieldViolations(t *testing.T, err error) []string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				require.NotEmpty(t, v.Description)
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestErrors_InvalidArgument(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-13-01", EndDate: "2024-05-31"})
+	require.Equal(t, []string{"start_date"}, fieldViolations(t, err))
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-31", EndDate: "2024-05-01"})
+	require.Equal(t, []string{"end_date"}, fieldViolations(t, err))
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{
+		StartTime: "2024-05-02T00:00:00Z",
+		EndTime:   "2024-05-01T00:00:00Z",
+	})
+	require.Equal(t, []string{"end_time"}, fieldViolations(t, err))
+
+	_, err = client.GetPeriodComparison(context.Background(), &pb.PeriodComparisonRequest{
+		CurrentPeriod:  &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"},
+		PreviousPeriod: &pb.ScoreRequest{StartDate: "April", EndDate: "2024-04-30"},
+	})
+	require.Equal(t, []string{"previous_period.start_date"}, fieldViolations(t, err))
+
+	_, err = client.GetScoreTrend(context.Background(), &pb.ScoreTrendRequest{
+		Period: &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31", Filter: &pb.TicketFilter{Teams: []string{""}}},
+	})
+	require.Equal(t, []string{"period.filter.teams"}, fieldViolations(t, err))
+
+	_, err = client.GetCategoryScores(context.Background(), &pb.ScoreRequest{
+		StartDate:   "2024-05-01",
+		EndDate:     "2024-05-31",
+		Granularity: pb.Granularity_GRANULARITY_WEEK,
+		RollingDays: 7,
+	})
+	require.Equal(t, []string{"rolling_days"}, fieldViolations(t, err))
+
+	_, err = client.GetCategoryScores(context.Background(), &pb.ScoreRequest{
+		StartDate:   "2024-05-01",
+		EndDate:     "2024-05-31",
+		RollingDays: 367,
+	})
+	require.Contains(t, status.Convert(err).Message(), "rolling_days: must be between 1 and 366, or 0 for no rolling scores")
+}
+
+func TestErrors_InternalHidesQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillReturnError(errors.New(`syntax error at or near "FROM ratings r"`))
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.NotContains(t, status.Convert(err).Message(), "ratings")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestErrors_ContextErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code codes.Code
+	}{
+		"deadline exceeded": {context.DeadlineExceeded, codes.DeadlineExceeded},
+		"canceled":          {context.Canceled, codes.Canceled},
+	} {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			client, cleanup := startTestGRPCServer(t, db)
+			defer cleanup()
+
+			mock.ExpectQuery("SELECT (.+) FROM ratings r").WillReturnError(tc.err)
+
+			_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+			require.Equal(t, tc.code, status.Code(err))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestErrors_NotEnoughHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2024, 5, 8, 9, 0, 0, 0, time.UTC)
+	client, cleanup := startTestGRPCServer(t, db, server.WithClock(func() time.Time { return now }))
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("GDPR", "2024-04-29", 2, 1.6, 2.0, 2.0, 1.6))
+
+	_, err = client.GetScoreForecast(context.Background(), &pb.ScoreForecastRequest{HistoryWeeks: 3})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// panickingServer panics in every handler it implements
+type panickingServer struct {
+	pb.UnimplementedScoringServiceServer
+}
+
+func (panickingServer) GetOverallScore(context.Context, *pb.ScoreRequest) (*pb.OverallScoreResponse, error) {
+	var r *pb.ScoreRequest
+	return nil, errors.New(r.StartDate)
+}
+
+func (panickingServer) StreamTicketScores(*pb.ScoreRequest, pb.ScoringService_StreamTicketScoresServer) error {
+	panic("stream handler failed")
+}
+
+// serve runs srv with the server options of the service
+func serve(t *testing.T, srv pb.ScoringServiceServer, requestTimeout time.Duration) pb.ScoringServiceClient {
+	t.Helper()
+	lis, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(server.ServerOptions(requestTimeout)...)
+	pb.RegisterScoringServiceServer(grpcServer, srv)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewScoringServiceClient(conn)
+}
+
+func TestErrors_RequestTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client := serve(t, server.NewTicketScoreServer(db, repository.SQLite), 50*time.Millisecond)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}))
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestErrors_RecoversFromPanics(t *testing.T) {
+	client := serve(t, panickingServer{}, 0)
+
+	_, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.NotContains(t, status.Convert(err).Message(), "nil pointer")
+
+	stream, err := client.StreamTicketScores(context.Background(), &pb.ScoreRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	// The server keeps serving after a panic
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
@@ -3,27 +3,31 @@ package integration
 import (
 	"context"
 	"database/sql"
+	"io"
 	"log"
 	"net"
 	"testing"
 	"time"
 
 	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
 	"ticket-score-engine/internal/server"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func startTestGRPCServer(t *testing.T, db *sql.DB) (pb.ScoringServiceClient, func()) {
+func startTestGRPCServer(t *testing.T, db *sql.DB, opts ...server.Option) (pb.ScoringServiceClient, func()) {
 	// Create listener
 	lis, err := net.Listen("tcp", ":0")
 	require.NoError(t, err)
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
-	srv := server.NewTicketScoreServer(db)
+	grpcServer := grpc.NewServer(server.ServerOptions(0)...)
+	srv := server.NewTicketScoreServer(db, repository.SQLite, opts...)
 	pb.RegisterScoringServiceServer(grpcServer, srv)
 
 	// Run server in background
@@ -53,12 +57,12 @@ func TestGetOverallScore(t *testing.T) {
 
 	// Set up expected SQL query and mock result
 	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) // end_date is inclusive
 
 	mock.ExpectQuery("SELECT (.+) FROM ratings r").
 		WithArgs(start, end).
-		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count"}).
-			AddRow(75.0, 100.0, 15))
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(75.0, 100.0, 15, 100.0, 75.0))
 
 	client, cleanup := startTestGRPCServer(t, db)
 	defer cleanup()
@@ -72,6 +76,9 @@ func TestGetOverallScore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, float32(75.0), resp.Score)
 	require.Equal(t, int32(15), resp.RatingCount)
+	require.Equal(t, 0.95, resp.ConfidenceInterval.Level)
+	require.InDelta(t, 65.7, resp.ConfidenceInterval.Lower, 0.1)
+	require.InDelta(t, 82.45, resp.ConfidenceInterval.Upper, 0.1)
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
@@ -82,13 +89,13 @@ func TestGetCategoryScores(t *testing.T) {
 	defer db.Close()
 
 	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)
 
 	mock.ExpectQuery("SELECT (.+) FROM ratings r").
 		WithArgs(start, end).
-		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight"}).
-			AddRow("GDPR", "2024-05-01", 10, 40.0, 50.0).
-			AddRow("Spelling", "2024-05-01", 5, 20.0, 25.0))
+		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("GDPR", "2024-05-01", 10, 40.0, 50.0, 50.0, 40.0).
+			AddRow("Spelling", "2024-05-01", 5, 20.0, 25.0, 25.0, 20.0))
 
 	client, cleanup := startTestGRPCServer(t, db)
 	defer cleanup()
@@ -104,6 +111,44 @@ func TestGetCategoryScores(t *testing.T) {
 	require.Equal(t, "GDPR", resp.Scores[0].CategoryName)
 	require.Equal(t, float32(80.0), resp.Scores[0].Score) // 40/50 * 100
 	require.Equal(t, int32(10), resp.Scores[0].RatingCount)
+	require.Equal(t, pb.Granularity_GRANULARITY_DAY, resp.Granularity)
+	require.False(t, resp.IsWeekly)
+	require.Equal(t, "2024-05-01", resp.Scores[0].PeriodStart)
+	require.Equal(t, "2024-05-01", resp.Scores[0].PeriodEnd)
+}
+
+func TestGetCategoryScores_ExplicitGranularity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("DATE\\(r.created_at, 'weekday 0', '-6 days'\\) as period").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("GDPR", "2024-04-29", 10, 40.0, 50.0, 50.0, 40.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	req := &pb.ScoreRequest{
+		StartDate:   "2024-05-01",
+		EndDate:     "2024-05-07",
+		Granularity: pb.Granularity_GRANULARITY_WEEK,
+	}
+
+	resp, err := client.GetCategoryScores(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, pb.Granularity_GRANULARITY_WEEK, resp.Granularity)
+	require.True(t, resp.IsWeekly)
+	require.Len(t, resp.Scores, 1)
+	require.Equal(t, "2024-18", resp.Scores[0].Date)
+	require.Equal(t, "2024-05-01", resp.Scores[0].PeriodStart)
+	require.Equal(t, "2024-05-05", resp.Scores[0].PeriodEnd)
+
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestGetTicketScores(t *testing.T) {
@@ -112,11 +157,12 @@ func TestGetTicketScores(t *testing.T) {
 	defer db.Close()
 
 	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
 
 	// Mocking 4 columns: ticket_id, category_name, weighted_score, total_weight
+	// The default page of 100 tickets is read with one more to spot a next page
 	mock.ExpectQuery("SELECT (.+) FROM ratings r").
-		WithArgs(start, end).
+		WithArgs(start, end, 101, start, end).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"ticket_id", "category", "weighted_score", "total_weight",
 		}).
@@ -144,6 +190,104 @@ func TestGetTicketScores(t *testing.T) {
 	require.Equal(t, float32(80.0), ticketMap[101]["Spelling"])
 	require.Equal(t, float32(50.0), ticketMap[101]["Grammer"])
 	require.Equal(t, float32(100.0), ticketMap[102]["GDPR"])
+
+	// Tickets are ordered by ID and everything fits on one page
+	require.Equal(t, int32(101), resp.TicketScores[0].TicketId)
+	require.Empty(t, resp.NextPageToken)
+}
+
+func ticketScoreRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"ticket_id", "category", "weighted_score", "total_weight"}).
+		AddRow(101, "Grammer", 30.0, 60.0).
+		AddRow(101, "Spelling", 40.0, 50.0).
+		AddRow(102, "GDPR", 90.0, 90.0).
+		AddRow(103, "GDPR", 45.0, 90.0)
+}
+
+func TestGetTicketScores_Pagination(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`LIMIT \?`).
+		WithArgs(start, end, 3, start, end).
+		WillReturnRows(ticketScoreRows())
+	mock.ExpectQuery(`AND r.ticket_id > \?\s+ORDER BY r.ticket_id\s+LIMIT \?`).
+		WithArgs(start, end, 102, 3, start, end, 102).
+		WillReturnRows(sqlmock.NewRows([]string{"ticket_id", "category", "weighted_score", "total_weight"}).
+			AddRow(103, "GDPR", 45.0, 90.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	req := &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-02",
+		PageSize:  2,
+	}
+
+	first, err := client.GetTicketScores(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, first.TicketScores, 2)
+	require.Equal(t, int32(101), first.TicketScores[0].TicketId)
+	require.Equal(t, int32(102), first.TicketScores[1].TicketId)
+	require.NotEmpty(t, first.NextPageToken)
+
+	req.PageToken = first.NextPageToken
+	second, err := client.GetTicketScores(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, second.TicketScores, 1)
+	require.Equal(t, int32(103), second.TicketScores[0].TicketId)
+	require.Equal(t, float32(50.0), second.TicketScores[0].CategoryScores["GDPR"])
+	require.Empty(t, second.NextPageToken)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	req.PageToken = "not-a-token"
+	_, err = client.GetTicketScores(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamTicketScores(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(ticketScoreRows())
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	stream, err := client.StreamTicketScores(context.Background(), &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-02",
+	})
+	require.NoError(t, err)
+
+	var ticketIDs []int32
+	for {
+		ticket, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ticketIDs = append(ticketIDs, ticket.TicketId)
+
+		if ticket.TicketId == 101 {
+			require.Equal(t, map[string]float32{"Grammer": 50.0, "Spelling": 80.0}, ticket.CategoryScores)
+		}
+	}
+
+	require.Equal(t, []int32{101, 102, 103}, ticketIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestGetPeriodComparison(t *testing.T) {
@@ -155,21 +299,21 @@ func TestGetPeriodComparison(t *testing.T) {
 	defer cleanup()
 
 	currentStart := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
-	currentEnd := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	currentEnd := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
 	previousStart := time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC)
-	previousEnd := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	previousEnd := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
 
 	mock.ExpectQuery("SELECT (.+) FROM ratings r").
 		WithArgs(currentStart, currentEnd).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"total_weighted_score", "total_weight", "rating_count",
-		}).AddRow(60.0, 100.0, 10))
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(60.0, 100.0, 10, 100.0, 60.0))
 
 	mock.ExpectQuery("SELECT (.+) FROM ratings r").
 		WithArgs(previousStart, previousEnd).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"total_weighted_score", "total_weight", "rating_count",
-		}).AddRow(40.0, 100.0, 8))
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(40.0, 100.0, 8, 100.0, 40.0))
 
 	req := &pb.PeriodComparisonRequest{
 		CurrentPeriod: &pb.ScoreRequest{
@@ -190,4 +334,730 @@ func TestGetPeriodComparison(t *testing.T) {
 	require.InDelta(t, 40.0, resp.PreviousScore, 0.01)
 	require.Equal(t, int32(10), resp.CurrentCount)
 	require.Equal(t, int32(8), resp.PreviousCount)
+	require.Less(t, resp.CurrentInterval.Lower, resp.CurrentScore)
+	require.Greater(t, resp.CurrentInterval.Upper, resp.CurrentScore)
+	require.Less(t, resp.PreviousInterval.Lower, resp.PreviousScore)
+	require.Greater(t, resp.PreviousInterval.Upper, resp.PreviousScore)
+	require.Equal(t, pb.BaselineStatus_BASELINE_OK, resp.Baseline)
+	require.InDelta(t, 20.0, resp.AbsoluteChange, 0.01)
+	require.InDelta(t, 0.0041, resp.PValue, 0.0001)
+	require.True(t, resp.IsSignificant)
+
+	// The same difference is not significant at a stricter alpha
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(currentStart, currentEnd).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(60.0, 100.0, 10, 100.0, 60.0))
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(previousStart, previousEnd).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(40.0, 100.0, 8, 100.0, 40.0))
+
+	req.Alpha = 0.001
+	resp, err = client.GetPeriodComparison(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsSignificant)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	req.Alpha = 1.5
+	_, err = client.GetPeriodComparison(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetPeriodComparison_NoBaseline(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(60.0, 100.0, 10, 100.0, 60.0))
+	// Sums over no ratings are NULL
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score",
+		}).AddRow(nil, nil, 0, nil, nil))
+
+	req := &pb.PeriodComparisonRequest{
+		CurrentPeriod:  &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-02"},
+		PreviousPeriod: &pb.ScoreRequest{StartDate: "2024-04-29", EndDate: "2024-04-30"},
+	}
+
+	resp, err := client.GetPeriodComparison(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, pb.BaselineStatus_BASELINE_NO_RATINGS, resp.Baseline)
+	require.Equal(t, float32(0), resp.PercentageChange)
+	require.Equal(t, int32(0), resp.PreviousCount)
+	require.Equal(t, 1.0, resp.PValue)
+	require.False(t, resp.IsSignificant)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPeriodComparison_Preset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	client, cleanup := startTestGRPCServer(t, db, server.WithClock(func() time.Time { return now }))
+	defer cleanup()
+
+	columns := []string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), now).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(8.0, 10.0, 10, 10.0, 8.0))
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 10, 12, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(9.0, 10.0, 10, 10.0, 9.0))
+
+	resp, err := client.GetPeriodComparison(context.Background(), &pb.PeriodComparisonRequest{
+		Preset: pb.PeriodPreset_PERIOD_PRESET_MONTH_TO_DATE,
+	})
+	require.NoError(t, err)
+	require.InDelta(t, 80.0, resp.CurrentScore, 0.01)
+	require.Equal(t, &pb.ResolvedPeriods{
+		CurrentStart:  "2024-05-01T00:00:00Z",
+		CurrentEnd:    "2024-05-10T12:00:00Z",
+		PreviousStart: "2024-04-01T00:00:00Z",
+		PreviousEnd:   "2024-04-10T12:00:00Z",
+	}, resp.Periods)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPeriodComparison_SamePeriodLastYear(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	columns := []string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(8.0, 10.0, 10, 10.0, 8.0))
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(9.0, 10.0, 10, 10.0, 9.0))
+
+	resp, err := client.GetPeriodComparison(context.Background(), &pb.PeriodComparisonRequest{
+		CurrentPeriod: &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"},
+		CompareTo:     pb.CompareTo_COMPARE_TO_SAME_PERIOD_LAST_YEAR,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2023-05-01T00:00:00Z", resp.Periods.PreviousStart)
+	require.Equal(t, "2023-06-01T00:00:00Z", resp.Periods.PreviousEnd)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPeriodComparison_InvalidPreset(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	for name, req := range map[string]*pb.PeriodComparisonRequest{
+		"preset with current dates": {
+			Preset:        pb.PeriodPreset_PERIOD_PRESET_LAST_7_DAYS,
+			CurrentPeriod: &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-07"},
+		},
+		"preset with previous dates": {
+			Preset:         pb.PeriodPreset_PERIOD_PRESET_WEEK_TO_DATE,
+			PreviousPeriod: &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-07"},
+		},
+		"rolling days without length": {Preset: pb.PeriodPreset_PERIOD_PRESET_ROLLING_DAYS},
+		"rolling days with other preset": {
+			Preset:      pb.PeriodPreset_PERIOD_PRESET_MONTH_TO_DATE,
+			RollingDays: 14,
+		},
+		"unknown preset": {Preset: pb.PeriodPreset(42)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetPeriodComparison(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetPeriodComparison_InvalidPeriods(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	may := &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"}
+	for name, tc := range map[string]struct {
+		req   *pb.PeriodComparisonRequest
+		field string
+	}{
+		"empty request":           {&pb.PeriodComparisonRequest{}, "current_period"},
+		"missing previous period": {&pb.PeriodComparisonRequest{CurrentPeriod: may}, "previous_period"},
+		"missing current period":  {&pb.PeriodComparisonRequest{PreviousPeriod: may}, "current_period"},
+		"empty previous period":   {&pb.PeriodComparisonRequest{CurrentPeriod: may, PreviousPeriod: &pb.ScoreRequest{}}, "previous_period.start_date"},
+		"overlapping periods": {
+			&pb.PeriodComparisonRequest{
+				CurrentPeriod:  may,
+				PreviousPeriod: &pb.ScoreRequest{StartDate: "2024-04-15", EndDate: "2024-05-15"},
+			},
+			"previous_period",
+		},
+		"same period": {&pb.PeriodComparisonRequest{CurrentPeriod: may, PreviousPeriod: may}, "previous_period"},
+		"current period over a year": {
+			&pb.PeriodComparisonRequest{
+				CurrentPeriod: &pb.ScoreRequest{StartDate: "2023-01-01", EndDate: "2024-05-31"},
+				CompareTo:     pb.CompareTo_COMPARE_TO_SAME_PERIOD_LAST_YEAR,
+			},
+			"current_period",
+		},
+		"previous period over a year": {
+			&pb.PeriodComparisonRequest{
+				CurrentPeriod:  may,
+				PreviousPeriod: &pb.ScoreRequest{StartDate: "2022-01-01", EndDate: "2024-04-30"},
+			},
+			"previous_period",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetPeriodComparison(context.Background(), tc.req)
+			require.Equal(t, []string{tc.field}, fieldViolations(t, err))
+
+			_, err = client.GetCategoryPeriodComparison(context.Background(), tc.req)
+			require.Equal(t, []string{tc.field}, fieldViolations(t, err))
+		})
+	}
+}
+
+func TestGetCategoryPeriodComparison(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	columns := []string{"category", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}
+	mock.ExpectQuery(`GROUP BY rc.name\s+ORDER BY rc.name`).
+		WithArgs(time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("GDPR", 20, 14.0, 20.0, 20.0, 14.0).
+			AddRow("Grammar", 10, 9.0, 10.0, 10.0, 9.0))
+	mock.ExpectQuery(`GROUP BY rc.name\s+ORDER BY rc.name`).
+		WithArgs(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("GDPR", 20, 18.0, 20.0, 20.0, 18.0).
+			AddRow("Grammar", 10, 8.0, 10.0, 10.0, 8.0))
+
+	req := &pb.PeriodComparisonRequest{
+		CurrentPeriod:  &pb.ScoreRequest{StartDate: "2024-05-08", EndDate: "2024-05-14"},
+		PreviousPeriod: &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-07"},
+	}
+
+	resp, err := client.GetCategoryPeriodComparison(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Categories, 2)
+
+	// GDPR dropped from 90% to 70%, so it comes first
+	gdpr := resp.Categories[0]
+	require.Equal(t, "GDPR", gdpr.CategoryName)
+	require.InDelta(t, 70.0, gdpr.Comparison.CurrentScore, 0.01)
+	require.InDelta(t, 90.0, gdpr.Comparison.PreviousScore, 0.01)
+	require.InDelta(t, -20.0, gdpr.Comparison.AbsoluteChange, 0.01)
+	require.InDelta(t, -22.22, gdpr.Comparison.PercentageChange, 0.01)
+	require.Equal(t, int32(20), gdpr.Comparison.CurrentCount)
+
+	grammar := resp.Categories[1]
+	require.Equal(t, "Grammar", grammar.CategoryName)
+	require.InDelta(t, 10.0, grammar.Comparison.AbsoluteChange, 0.01)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetScoreTrend(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	// One grouped query for the whole series
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("GDPR", "2024-05-01", 2, 1.0, 2.0, 2.0, 1.0).
+			AddRow("GDPR", "2024-05-03", 1, 1.0, 1.0, 1.0, 1.0).
+			AddRow("Spelling", "2024-05-01", 2, 2.0, 2.0, 2.0, 2.0))
+
+	resp, err := client.GetScoreTrend(context.Background(), &pb.ScoreTrendRequest{
+		Period:            &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-03", Granularity: pb.Granularity_GRANULARITY_DAY},
+		IncludeCategories: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Equal(t, pb.Granularity_GRANULARITY_DAY, resp.Granularity)
+	require.Len(t, resp.Overall, 3)
+	require.Equal(t, "2024-05-02", resp.Overall[1].Date)
+	require.Equal(t, int32(0), resp.Overall[1].RatingCount)
+	require.Equal(t, int32(4), resp.Overall[0].RatingCount)
+	require.InDelta(t, 75.0, resp.Overall[0].Score, 0.01)
+
+	require.Len(t, resp.Categories, 2)
+	require.Equal(t, "Spelling", resp.Categories[1].CategoryName)
+	require.Len(t, resp.Categories[1].Points, 3)
+	require.Equal(t, int32(0), resp.Categories[1].Points[2].RatingCount)
+
+	_, err = client.GetScoreTrend(context.Background(), &pb.ScoreTrendRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for name, period := range map[string]*pb.ScoreRequest{
+		"auto":          {StartDate: "2024-05-01", EndDate: "2024-05-03"},
+		"quarter":       {StartDate: "2024-01-01", EndDate: "2024-12-31", Granularity: pb.Granularity_GRANULARITY_QUARTER},
+		"too many days": {StartDate: "2023-01-01", EndDate: "2024-01-02", Granularity: pb.Granularity_GRANULARITY_DAY},
+	} {
+		_, err = client.GetScoreTrend(context.Background(), &pb.ScoreTrendRequest{Period: period})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	// A year of days, leap day included, and decades of months fit
+	for _, period := range []*pb.ScoreRequest{
+		{StartDate: "2024-01-01", EndDate: "2024-12-31", Granularity: pb.Granularity_GRANULARITY_DAY},
+		{StartDate: "2000-01-01", EndDate: "2024-12-31", Granularity: pb.Granularity_GRANULARITY_MONTH},
+	} {
+		mock.ExpectQuery("SELECT (.+) FROM ratings r").
+			WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}))
+		_, err = client.GetScoreTrend(context.Background(), &pb.ScoreTrendRequest{Period: period})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetScoreForecast(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Wednesday of the week starting May 6th
+	now := time.Date(2024, 5, 8, 9, 0, 0, 0, time.UTC)
+	client, cleanup := startTestGRPCServer(t, db, server.WithClock(func() time.Time { return now }))
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("GDPR", "2024-04-15", 2, 1.2, 2.0, 2.0, 1.2).
+			AddRow("GDPR", "2024-04-22", 2, 1.4, 2.0, 2.0, 1.4).
+			AddRow("GDPR", "2024-04-29", 2, 1.6, 2.0, 2.0, 1.6))
+
+	resp, err := client.GetScoreForecast(context.Background(), &pb.ScoreForecastRequest{
+		Weeks:             2,
+		HistoryWeeks:      3,
+		IncludeCategories: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, resp.Overall, 2)
+	require.Equal(t, "2024-05-06", resp.Overall[0].PeriodStart)
+	require.Equal(t, "2024-05-12", resp.Overall[0].PeriodEnd)
+	require.InDelta(t, 90.0, resp.Overall[0].Score, 0.01)
+	require.InDelta(t, 100.0, resp.Overall[1].Score, 0.01)
+	require.Equal(t, 0.95, resp.Overall[0].PredictionInterval.Level)
+	require.Len(t, resp.Categories, 1)
+	require.Equal(t, "GDPR", resp.Categories[0].CategoryName)
+
+	for name, req := range map[string]*pb.ScoreForecastRequest{
+		"too many weeks":        {Weeks: 27},
+		"too little history":    {HistoryWeeks: 2},
+		"unknown time zone":     {TimeZone: "Mars/Olympus_Mons"},
+		"confidence level of 1": {ConfidenceLevel: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetScoreForecast(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetAnomalies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	columns := []string{"category", "period", "count", "weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}
+	start := time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC)
+
+	// Three perfect Mondays, then nothing but 0-star ratings
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC), start).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("GDPR", "2024-05-06", 4, 4.0, 4.0, 4.0, 4.0).
+			AddRow("GDPR", "2024-05-13", 4, 4.0, 4.0, 4.0, 4.0).
+			AddRow("GDPR", "2024-05-20", 4, 4.0, 4.0, 4.0, 4.0))
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, start.AddDate(0, 0, 1)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("GDPR", "2024-05-27", 4, 0.0, 4.0, 4.0, 0.0))
+
+	resp, err := client.GetAnomalies(context.Background(), &pb.AnomalyRequest{
+		Period:        &pb.ScoreRequest{StartDate: "2024-05-27", EndDate: "2024-05-27"},
+		BaselineWeeks: 3,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Len(t, resp.Anomalies, 1)
+	require.Equal(t, "GDPR", resp.Anomalies[0].CategoryName)
+	require.Equal(t, "2024-05-27", resp.Anomalies[0].Date)
+	require.Equal(t, float32(100), resp.Anomalies[0].ExpectedScore)
+	require.Equal(t, float32(96.5), resp.Anomalies[0].ExpectedLower)
+	require.Equal(t, pb.AnomalySeverity_ANOMALY_SEVERITY_CRITICAL, resp.Anomalies[0].Severity)
+
+	for name, req := range map[string]*pb.AnomalyRequest{
+		"missing period":       {},
+		"negative threshold":   {Period: &pb.ScoreRequest{StartDate: "2024-05-27", EndDate: "2024-05-27"}, Threshold: -1},
+		"baseline over a year": {Period: &pb.ScoreRequest{StartDate: "2024-05-27", EndDate: "2024-05-27"}, BaselineWeeks: 53},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetAnomalies(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	_, err = client.GetAnomalies(context.Background(), &pb.AnomalyRequest{Period: &pb.ScoreRequest{StartDate: "2024-05-27", EndDate: "2024-05-27"}, BaselineWeeks: -1})
+	require.Contains(t, status.Convert(err).Message(), "baseline_weeks: must be between 1 and 52, or 0 for the default of 8")
+}
+
+func TestBatchSubmitRatings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT COUNT\\(1\\) FROM rating_categories").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO ratings").
+		WithArgs(4, 101, 1, sqlmock.AnyArg(), sqlmock.AnyArg(), createdAt).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectCommit()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	req := &pb.BatchSubmitRatingsRequest{
+		Ratings: []*pb.SubmitRatingRequest{
+			{TicketId: 101, RatingCategoryId: 1, Rating: 4, CreatedAt: "2024-05-01T10:00:00Z"},
+			{TicketId: 102, RatingCategoryId: 1, Rating: 9},
+			{TicketId: 103, RatingCategoryId: 1, Rating: 3, CreatedAt: "yesterday"},
+		},
+	}
+
+	resp, err := client.BatchSubmitRatings(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.AcceptedCount)
+	require.Equal(t, int32(2), resp.RejectedCount)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, int64(7), resp.Results[0].RatingId)
+	require.Empty(t, resp.Results[0].Error)
+	require.NotEmpty(t, resp.Results[1].Error)
+	require.NotEmpty(t, resp.Results[2].Error)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBatchSubmitRatings_NilRating(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Only reachable in process, the wire has no nil entries
+	resp, err := server.NewTicketScoreServer(db, repository.SQLite).BatchSubmitRatings(context.Background(), &pb.BatchSubmitRatingsRequest{
+		Ratings: []*pb.SubmitRatingRequest{nil},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.RejectedCount)
+	require.NotEmpty(t, resp.Results[0].Error)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListCategories(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	archivedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT id, name, weight, archived_at FROM rating_categories").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "weight", "archived_at"}).
+			AddRow(1, "Spelling", 1.0, nil).
+			AddRow(2, "Tone", 0.3, archivedAt))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	resp, err := client.ListCategories(context.Background(), &pb.ListCategoriesRequest{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Categories, 2)
+	require.Equal(t, "Spelling", resp.Categories[0].Name)
+	require.False(t, resp.Categories[0].Archived)
+	require.True(t, resp.Categories[1].Archived)
+	require.Equal(t, "2024-05-01T00:00:00Z", resp.Categories[1].ArchivedAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOverallScore_TimeZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Midnight in Singapore (UTC+8) is 16:00 UTC on the previous day
+	start := time.Date(2024, 4, 30, 16, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 31, 16, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(75.0, 100.0, 15, 100.0, 75.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-31",
+		TimeZone:  "Asia/Singapore",
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-31",
+		TimeZone:  "Mars/Olympus_Mons",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOverallScore_TimestampRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(3.0, 4.0, 4, 4.0, 3.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	resp, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{
+		StartTime: "2024-05-01T10:00:00+02:00",
+		EndTime:   "2024-05-01T12:30:00Z",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), resp.RatingCount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOverallScore_InvalidRange(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	for name, req := range map[string]*pb.ScoreRequest{
+		"end date before start date": {StartDate: "2024-05-02", EndDate: "2024-05-01"},
+		"empty timestamp range":      {StartTime: "2024-05-01T10:00:00Z", EndTime: "2024-05-01T10:00:00Z"},
+		"date and time for start":    {StartDate: "2024-05-01", StartTime: "2024-05-01T10:00:00Z", EndDate: "2024-05-02"},
+		"malformed end time":         {StartDate: "2024-05-01", EndTime: "2024-05-02"},
+		"confidence level of 1":      {StartDate: "2024-05-01", EndDate: "2024-05-02", ConfidenceLevel: 1},
+		"rolling over a year":        {StartDate: "2024-05-01", EndDate: "2024-05-02", RollingDays: 400},
+		"negative half-life":         {StartDate: "2024-05-01", EndDate: "2024-05-02", DecayHalfLifeDays: -1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetOverallScore(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetOverallScore_TicketFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`JOIN tickets t ON t.id = r.ticket_id`).
+		WithArgs(start, end, "billing", "chat", "email", "vip").
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(9.0, 10.0, 10, 10.0, 9.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	req := &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-31",
+		Filter: &pb.TicketFilter{
+			Teams:    []string{"billing"},
+			Channels: []string{"chat", "email"},
+			Tags:     []string{"vip"},
+		},
+	}
+
+	resp, err := client.GetOverallScore(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, float32(90.0), resp.Score)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	req.Filter.Tags = []string{" "}
+	_, err = client.GetOverallScore(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOverallScore_Smoothing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	// A single perfect rating
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(1.0, 1.0, 1, 1.0, 1.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	req := &pb.ScoreRequest{
+		StartDate: "2024-05-01",
+		EndDate:   "2024-05-01",
+		Smoothing: &pb.Smoothing{Mode: pb.SmoothingMode_SMOOTHING_PRIOR, PriorScore: 50, Strength: 4},
+	}
+
+	resp, err := client.GetOverallScore(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, float32(100.0), resp.Score)
+	require.Equal(t, float32(60.0), resp.SmoothedScore)
+	require.Equal(t, 1.0, resp.EffectiveSampleSize)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	req.Smoothing.PriorScore = 120
+	_, err = client.GetOverallScore(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOverallScore_RollingAndDecayed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)
+	columns := []string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1.0, 2.0, 2, 2.0, 1.0))
+	// The 7 days up to May 3rd
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WithArgs(time.Date(2024, 4, 27, 0, 0, 0, 0, time.UTC), end).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(6.0, 10.0, 10, 10.0, 6.0))
+	mock.ExpectQuery("GROUP BY day").
+		WillReturnRows(sqlmock.NewRows([]string{"day", "rating_count", "total_weighted_score", "total_weight", "total_squared_weight", "weighted_squared_score"}).
+			AddRow("2024-05-02", 1, 1.0, 1.0, 1.0, 1.0).
+			AddRow("2024-05-03", 1, 0.0, 1.0, 1.0, 0.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	resp, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{
+		StartDate:         "2024-05-02",
+		EndDate:           "2024-05-03",
+		RollingDays:       7,
+		DecayHalfLifeDays: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, float32(50.0), resp.Score)
+	require.Equal(t, float32(60.0), resp.RollingScore)
+	require.Equal(t, int32(10), resp.RollingRatingCount)
+	require.InDelta(t, 100.0/3, resp.DecayedScore, 0.001)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	previousStart := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"entity", "category", "count", "weighted_score", "total_weight"}
+	mock.ExpectQuery("GROUP BY t.team, rc.name").
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("billing", "GDPR", 4, 2.0, 4.0).
+			AddRow("shipping", "GDPR", 4, 3.6, 4.0).
+			AddRow("sales", "GDPR", 1, 1.0, 1.0))
+	mock.ExpectQuery("GROUP BY t.team, rc.name").
+		WithArgs(previousStart, start).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("billing", "GDPR", 4, 3.6, 4.0).
+			AddRow("shipping", "GDPR", 4, 2.0, 4.0))
+
+	client, cleanup := startTestGRPCServer(t, db)
+	defer cleanup()
+
+	resp, err := client.GetLeaderboard(context.Background(), &pb.LeaderboardRequest{
+		Period:         &pb.ScoreRequest{StartDate: "2024-05-08", EndDate: "2024-05-14"},
+		Dimension:      pb.LeaderboardDimension_LEADERBOARD_DIMENSION_TEAM,
+		MinRatingCount: 2,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Equal(t, "2024-05-01T00:00:00Z", resp.PreviousPeriodStart)
+	require.Equal(t, "2024-05-08T00:00:00Z", resp.PreviousPeriodEnd)
+	require.Len(t, resp.Entries, 2)
+
+	require.Equal(t, "shipping", resp.Entries[0].Key)
+	require.Equal(t, int32(1), resp.Entries[0].Rank)
+	require.Equal(t, float32(90.0), resp.Entries[0].Score)
+	require.Equal(t, int32(2), resp.Entries[0].PreviousRank)
+	require.Equal(t, int32(1), resp.Entries[0].RankChange)
+	require.Len(t, resp.Entries[0].Categories, 1)
+
+	require.Equal(t, "billing", resp.Entries[1].Key)
+	require.Equal(t, int32(-1), resp.Entries[1].RankChange)
+
+	_, err = client.GetLeaderboard(context.Background(), &pb.LeaderboardRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/server"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthChecker_ReadinessFollowsDatabase(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(server.ServerOptions(0)...)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	checker := server.NewHealthChecker(repository.NewHealthRepository(db), healthServer, time.Second)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status(server.LivenessService))
+
+	mock.ExpectPing()
+	require.NoError(t, checker.Check(context.Background()))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status(pb.ScoringService_ServiceDesc.ServiceName))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	require.Error(t, checker.Check(context.Background()))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(pb.ScoringService_ServiceDesc.ServiceName))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status(server.LivenessService))
+
+	// Run pings right away, so readiness recovers without waiting an interval
+	mock.ExpectPing()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		checker.Run(ctx, time.Hour)
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		return status("") == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/server"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+// listServices asks the reflection service of a server registered like the
+// server binary for the names of its services
+func listServices(t *testing.T, reflect bool) ([]string, error) {
+	t.Helper()
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer(server.ServerOptions(0)...)
+	server.RegisterServices(grpcServer, server.NewTicketScoreServer(db, repository.SQLite), health.NewServer(), reflect)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var names []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		names = append(names, service.Name)
+	}
+	return names, nil
+}
+
+func TestReflection_ListsServicesWhenEnabled(t *testing.T) {
+	names, err := listServices(t, true)
+	require.NoError(t, err)
+	require.Contains(t, names, pb.ScoringService_ServiceDesc.ServiceName)
+	require.Contains(t, names, "grpc.health.v1.Health")
+	require.Contains(t, names, reflectionpb.ServerReflection_ServiceDesc.ServiceName)
+}
+
+func TestReflection_UnimplementedWhenDisabled(t *testing.T) {
+	_, err := listServices(t, false)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
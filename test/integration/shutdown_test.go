@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"context"
+	"net"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "ticket-score-engine/generated"
+	"ticket-score-engine/internal/repository"
+	"ticket-score-engine/internal/server"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// startedServer reports when GetOverallScore calls come in
+type startedServer struct {
+	pb.ScoringServiceServer
+	started chan struct{}
+}
+
+func (s startedServer) GetOverallScore(ctx context.Context, req *pb.ScoreRequest) (*pb.OverallScoreResponse, error) {
+	s.started <- struct{}{}
+	return s.ScoringServiceServer.GetOverallScore(ctx, req)
+}
+
+// runUntilSignal serves srv like the server binary until SIGTERM and returns
+// a client and the result of serving
+func runUntilSignal(t *testing.T, srv pb.ScoringServiceServer, drainDelay, drainTimeout time.Duration) (pb.ScoringServiceClient, *health.Server, <-chan error) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(server.ServerOptions(0)...)
+	pb.RegisterScoringServiceServer(grpcServer, srv)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(server.LivenessService, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	t.Cleanup(stop)
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(ctx, grpcServer, lis, healthServer, drainDelay, drainTimeout)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewScoringServiceClient(conn), healthServer, served
+}
+
+func healthStatus(t *testing.T, healthServer *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestServe_DrainsCallsOnSIGTERM(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillDelayFor(300 * time.Millisecond).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(75.0, 100.0, 15, 100.0, 75.0))
+	mock.ExpectClose()
+
+	started := make(chan struct{}, 1)
+	client, healthServer, served := runUntilSignal(t, startedServer{server.NewTicketScoreServer(db, repository.SQLite), started}, 0, 5*time.Second)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, healthServer, ""))
+
+	type result struct {
+		resp *pb.OverallScoreResponse
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+		results <- result{resp, err}
+	}()
+
+	<-started
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	// The call in flight finishes, while the server already reports it is going away
+	require.Eventually(t, func() bool {
+		return healthStatus(t, healthServer, "") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+	r := <-results
+	require.NoError(t, r.err)
+	require.Equal(t, float32(75), r.resp.Score)
+
+	require.NoError(t, <-served)
+	_, err = client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// As in the server binary, the database is closed once serving returned
+	require.NoError(t, db.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestServe_StopsCallsAfterDrainTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillDelayFor(10 * time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}))
+
+	started := make(chan struct{}, 1)
+	client, _, served := runUntilSignal(t, startedServer{server.NewTicketScoreServer(db, repository.SQLite), started}, 0, 100*time.Millisecond)
+
+	results := make(chan error, 1)
+	go func() {
+		_, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+		results <- err
+	}()
+
+	<-started
+	begin := time.Now()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	require.NoError(t, <-served)
+	require.Less(t, time.Since(begin), 5*time.Second)
+	require.Equal(t, codes.Unavailable, status.Code(<-results))
+}
+
+func TestServe_DrainsWithoutLimitWhenTimeoutIsZero(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillDelayFor(300 * time.Millisecond).
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(75.0, 100.0, 15, 100.0, 75.0))
+
+	started := make(chan struct{}, 1)
+	client, _, served := runUntilSignal(t, startedServer{server.NewTicketScoreServer(db, repository.SQLite), started}, 0, 0)
+
+	results := make(chan error, 1)
+	go func() {
+		_, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+		results <- err
+	}()
+
+	<-started
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	require.NoError(t, <-results)
+	require.NoError(t, <-served)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestServe_AcceptsCallsDuringDrainDelay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM ratings r").
+		WillReturnRows(sqlmock.NewRows([]string{"total_weighted_score", "total_weight", "rating_count", "total_squared_weight", "weighted_squared_score"}).
+			AddRow(75.0, 100.0, 15, 100.0, 75.0))
+
+	started := make(chan struct{}, 1)
+	client, healthServer, served := runUntilSignal(t, startedServer{server.NewTicketScoreServer(db, repository.SQLite), started}, time.Second, 5*time.Second)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, healthServer, ""))
+
+	begin := time.Now()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	require.Eventually(t, func() bool {
+		return healthStatus(t, healthServer, "") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+	// Only readiness is down, so the drain is not taken for a hung process
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, healthServer, server.LivenessService))
+
+	// Clients that have not noticed yet are still served
+	resp, err := client.GetOverallScore(context.Background(), &pb.ScoreRequest{StartDate: "2024-05-01", EndDate: "2024-05-31"})
+	require.NoError(t, err)
+	require.Equal(t, float32(75), resp.Score)
+	<-started
+
+	require.NoError(t, <-served)
+	require.GreaterOrEqual(t, time.Since(begin), time.Second)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus(t, healthServer, server.LivenessService))
+	require.NoError(t, mock.ExpectationsWereMet())
+}